@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"runtime/debug"
 	"strings"
+	"sync"
 
 	"github.com/project-flogo/core/action"
 	"github.com/project-flogo/core/activity"
@@ -23,6 +24,7 @@ import (
 	"github.com/project-flogo/core/support/connection"
 	"github.com/project-flogo/core/support/log"
 	"github.com/project-flogo/core/support/managed"
+	"github.com/project-flogo/core/support/redact"
 	"github.com/project-flogo/core/support/service"
 	"github.com/project-flogo/core/trigger"
 )
@@ -69,17 +71,20 @@ func (ae *appEvent) AppEnv() string {
 	return ae.env
 }
 
+// Redact implements redact.Redactor
+func (ae *appEvent) Redact() interface{} {
+	return &appEvent{status: ae.status, name: redact.String(ae.name), version: redact.String(ae.version), env: redact.String(ae.env)}
+}
+
 var flogoImportPattern = regexp.MustCompile(`^(([^ ]*)[ ]+)?([^@:]*)@?([^:]*)?:?(.*)?$`) // extract import path even if there is an alias and/or a version
 
 func New(config *Config, runner action.Runner, options ...Option) (*App, error) {
 
 	app := &App{stopOnError: true, name: config.Name, version: config.Version, env: os.Getenv("FLOGO_ENV")}
-	if property.IsPropertyReconfigureEnabled() {
-		// Preserve original configuration and options
-		app.config, _ = json.Marshal(config)
-		app.options = options
-		app.actionRunner = runner
-	}
+	// Preserve original configuration and options, used to reconfigure the app
+	app.config, _ = json.Marshal(config)
+	app.options = options
+	app.actionRunner = runner
 
 	properties := make(map[string]interface{}, len(config.Properties))
 	for _, attr := range config.Properties {
@@ -87,6 +92,7 @@ func New(config *Config, runner action.Runner, options ...Option) (*App, error)
 	}
 
 	app.propManager = property.NewManager(properties)
+	app.propDefs = config.PropertyDefinitions()
 	property.SetDefaultManager(app.propManager)
 
 	for _, option := range options {
@@ -96,6 +102,11 @@ func New(config *Config, runner action.Runner, options ...Option) (*App, error)
 		}
 	}
 
+	// properties are validated once all the property post processors have run
+	if err := app.validateProperties(); err != nil {
+		return nil, err
+	}
+
 	if app.srvManager == nil {
 		app.srvManager = service.NewServiceManager()
 	}
@@ -251,6 +262,19 @@ func ContinueOnError(a *App) error {
 	return nil
 }
 
+// validateProperties validates the properties against their definitions, if the app is configured to continue on
+// error the invalid properties are logged
+func (a *App) validateProperties() error {
+	err := property.Validate(a.propManager.GetProperties(), a.propDefs)
+	if err != nil {
+		if a.stopOnError {
+			return err
+		}
+		log.RootLogger().Warnf("Continuing with %s", err.Error())
+	}
+	return nil
+}
+
 func FinalizeProperties(processors ...property.PostProcessor) func(*App) error {
 	return func(a *App) error {
 		return a.propManager.Finalize(processors...)
@@ -262,6 +286,7 @@ type App struct {
 	version        string
 	env            string
 	propManager    *property.Manager
+	propDefs       []*property.Definition
 	resManager     *resource.Manager
 	srvManager     *service.Manager
 	actions        map[string]action.Action
@@ -273,6 +298,10 @@ type App struct {
 	config         []byte
 	options        []Option
 	actionRunner   action.Runner
+	healthChecks   []string
+
+	// mutex guards the triggers, properties and configuration, which are replaced when the app is reconfigured
+	mutex sync.RWMutex
 }
 
 type triggerWrapper struct {
@@ -292,6 +321,8 @@ func (t triggerWrapper) GetStatus() *managed.StatusInfo {
 }
 
 func (a *App) GetProperty(name string) (interface{}, bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
 	return a.propManager.GetProperty(name)
 }
 
@@ -319,8 +350,16 @@ func (a *App) Version() interface{} {
 	return a.version
 }
 
+// Env returns the name of the environment the app is running in e.g. dev, test, prod
+func (a *App) Env() string {
+	return a.env
+}
+
 // TriggerStatuses gets the status information for the triggers
 func (a *App) TriggerStatuses() []*managed.StatusInfo {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
 	statuses := make([]*managed.StatusInfo, 0, len(a.triggers))
 	for _, trg := range a.triggers {
 		statuses = append(statuses, trg.GetStatus())
@@ -337,6 +376,11 @@ func (a *App) Start() error {
 
 	logger := log.ChildLogger(log.RootLogger(), "engine")
 
+	// accept events again if the app was previously drained
+	trigger.ResumeAccepting()
+
+	a.registerHealthChecks()
+
 	managers := connection.Managers()
 
 	if len(managers) > 0 {
@@ -429,6 +473,7 @@ func (a *App) Start() error {
 		}
 
 		if len(failed) > 0 {
+			a.mutex.Lock()
 			//remove failed trigger, we have no use for them
 			for _, triggerId := range failed {
 				for index, tr := range a.triggers {
@@ -438,11 +483,17 @@ func (a *App) Start() error {
 					}
 				}
 			}
+			a.mutex.Unlock()
 		}
 
 		logger.Info("Triggers Started")
 	}
+
+	trigger.SetFlowController(a.newTriggerFlowController())
+
+	a.mutex.Lock()
 	a.started = true
+	a.mutex.Unlock()
 	return nil
 }
 
@@ -475,13 +526,20 @@ func (a *App) Stop() error {
 
 	logger := log.RootLogger()
 
-	if len(a.triggers) > 0 {
+	// circuit breakers must no longer pause or resume the triggers
+	trigger.SetFlowController(nil)
+
+	a.mutex.RLock()
+	triggers := a.triggers
+	a.mutex.RUnlock()
+
+	if len(triggers) > 0 {
 		logger.Info("Stopping Triggers...")
 
 		var lifecycleTriggers []*triggerWrapper
 		var normalTriggers []*triggerWrapper
 
-		for _, trgW := range a.triggers {
+		for _, trgW := range triggers {
 			if _, ok := trgW.trg.(LifecycleAware); ok {
 				lifecycleTriggers = append(lifecycleTriggers, trgW)
 			} else {
@@ -566,11 +624,16 @@ func (a *App) Stop() error {
 
 	logger.Debugf("Cleaning up resources")
 	a.resManager.CleanupResources()
+
+	a.unregisterHealthChecks()
 	return nil
 }
 
 // Reconfigure function restarts the app
 func (a *App) Reconfigure() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
 	logger := log.RootLogger()
 	if !a.started {
 		return fmt.Errorf("app is not started")
@@ -598,6 +661,10 @@ func (a *App) Reconfigure() error {
 			return err
 		}
 	}
+	err = a.validateProperties()
+	if err != nil {
+		return err
+	}
 	logger.Info("App properties are successfully reconfigured")
 
 	// Reconfigure connections
@@ -682,8 +749,9 @@ func getContribType(ref string) string {
 }
 
 func (a *App) PostAppEvent(appStatus Status) {
+	updateHealth(appStatus)
 	if event.HasListener(AppEventType) {
-		ae := &appEvent{name: a.name, version: a.version, status: appStatus}
+		ae := &appEvent{name: a.name, version: a.version, status: appStatus, env: a.env}
 		event.Post(AppEventType, ae)
 	}
 }
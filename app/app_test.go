@@ -2,10 +2,15 @@ package app
 
 import (
 	"encoding/json"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/project-flogo/core/data/mapper/config"
 	_ "github.com/project-flogo/core/examples/action"
 	_ "github.com/project-flogo/core/examples/trigger"
+	"github.com/project-flogo/core/trigger"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -67,3 +72,92 @@ func TestApp(t *testing.T) {
 	err = app.Stop()
 	assert.Nil(t, err)
 }
+
+func TestAppStartAfterDrain(t *testing.T) {
+	var cfg *Config
+	err := json.Unmarshal([]byte(app), &cfg)
+	assert.Nil(t, err)
+
+	app, err := New(cfg, nil, ContinueOnError)
+	assert.Nil(t, err)
+
+	err = app.Start()
+	assert.Nil(t, err)
+
+	report := app.Drain(10 * time.Millisecond)
+	assert.True(t, report.Completed)
+	assert.True(t, trigger.IsDraining())
+
+	err = app.Stop()
+	assert.Nil(t, err)
+
+	// draining applies to all the handlers of the process, starting an app accepts events again
+	app, err = New(cfg, nil, ContinueOnError)
+	assert.Nil(t, err)
+
+	err = app.Start()
+	assert.Nil(t, err)
+	assert.False(t, trigger.IsDraining())
+
+	err = app.Stop()
+	assert.Nil(t, err)
+}
+
+func TestAppMappingCheck(t *testing.T) {
+	defer os.Unsetenv(config.EnvMappingCheck)
+
+	badApp := strings.Replace(app, `"=$.anOutput"`, `"=$.anOuptut"`, 1)
+
+	var cfg *Config
+	err := json.Unmarshal([]byte(badApp), &cfg)
+	assert.Nil(t, err)
+
+	// problems are only logged by default
+	_, err = New(cfg, nil, ContinueOnError)
+	assert.Nil(t, err)
+
+	os.Setenv(config.EnvMappingCheck, config.MappingCheckError)
+
+	err = json.Unmarshal([]byte(badApp), &cfg)
+	assert.Nil(t, err)
+
+	_, err = New(cfg, nil, ContinueOnError)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "trigger[my_trigger].handler[my_trigger_handler1].actions[0].input.in: unknown reference '$.anOuptut'")
+	}
+}
+
+func TestAppPropertyConstraints(t *testing.T) {
+	propApp := strings.Replace(app, `"imports": [`, `"properties": [
+	  {"name": "port", "type": "int", "value": 8080, "min": 1, "max": 65535, "description": "The port"},
+	  {"name": "level", "type": "string", "value": "trace", "allowed": ["debug", "info"]},
+	  {"name": "host", "type": "string", "required": true}
+	],
+	"imports": [`, 1)
+
+	var cfg *Config
+	err := json.Unmarshal([]byte(propApp), &cfg)
+	assert.Nil(t, err)
+	assert.Len(t, cfg.PropertyConstraints, 3)
+	assert.Equal(t, "The port", cfg.PropertyConstraints["port"].Description)
+	assert.Equal(t, 65535.0, *cfg.PropertyConstraints["port"].Max)
+
+	// the constraints are retained when the config is serialized
+	cfgJson, err := json.Marshal(cfg)
+	assert.Nil(t, err)
+	var cfg2 *Config
+	err = json.Unmarshal(cfgJson, &cfg2)
+	assert.Nil(t, err)
+	assert.Equal(t, cfg.PropertyConstraints, cfg2.PropertyConstraints)
+	assert.Equal(t, 8080, cfg2.Properties[0].Value())
+
+	_, err = New(cfg, nil)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "2 invalid app properties:\n  - 'host': a value is required\n  - 'level': value 'trace' is not one of the allowed values [debug info]", err.Error())
+	}
+
+	// invalid properties are only logged when continuing on error
+	a, err := New(cfg, nil, ContinueOnError)
+	assert.Nil(t, err)
+	assert.NotNil(t, a)
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/mapper"
+	"github.com/project-flogo/core/data/mapper/config"
+	"github.com/project-flogo/core/data/schema"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/trigger"
+)
+
+// checkHandlerMappings checks a handler's conditions and mappings, depending on the configured mapping check
+// mode the problems found are ignored, logged as warnings or returned as a single error
+func checkHandlerMappings(triggerId string, md *trigger.Metadata, hConfig *trigger.HandlerConfig, acts []action.Action, ef expression.Factory) error {
+	mode := config.GetMappingCheckMode()
+	if mode == config.MappingCheckOff {
+		return nil
+	}
+
+	diagnostics := checkHandler(triggerId, md, hConfig, acts, ef)
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	if mode == config.MappingCheckError {
+		return diagnosticsError(diagnostics)
+	}
+
+	for _, d := range diagnostics {
+		log.RootLogger().Warnf("Mapping check: %s", d.Error())
+	}
+	return nil
+}
+
+// checkHandler statically checks the conditions and mappings of a handler's actions. The trigger output is the
+// scope of the conditions and input mappings and the action output is the scope of the output mappings.
+func checkHandler(triggerId string, md *trigger.Metadata, hConfig *trigger.HandlerConfig, acts []action.Action, ef expression.Factory) []*expression.Diagnostic {
+
+	var diagnostics []*expression.Diagnostic
+
+	triggerEnv := &expression.CheckEnv{}
+	var reply map[string]data.TypedValue
+	if md != nil {
+		triggerEnv.Scope = withSchemas(md.Output, hConfig.Schemas)
+		reply = md.Reply
+	}
+
+	for i, act := range acts {
+		if i >= len(hConfig.Actions) {
+			break
+		}
+		aConfig := hConfig.Actions[i]
+		location := fmt.Sprintf("trigger[%s].handler[%s].actions[%d]", triggerId, hConfig.Name, i)
+
+		var input, output map[string]data.TypedValue
+		if io := act.IOMetadata(); io != nil {
+			input = io.Input
+			output = io.Output
+		}
+
+		if aConfig.If != "" {
+			expr, err := ef.NewExpr(aConfig.If)
+			if err == nil {
+				_, errs := expression.Check(expr, triggerEnv)
+				for _, err := range errs {
+					diagnostics = append(diagnostics, &expression.Diagnostic{Location: location + ".if", Err: err})
+				}
+			}
+		}
+
+		for _, d := range mapper.CheckMappings(ef, aConfig.Input, triggerEnv, input) {
+			d.Location = location + ".input." + d.Location
+			diagnostics = append(diagnostics, d)
+		}
+
+		outputEnv := &expression.CheckEnv{Scope: output}
+		for _, d := range mapper.CheckMappings(ef, aConfig.Output, outputEnv, reply) {
+			d.Location = location + ".output." + d.Location
+			diagnostics = append(diagnostics, d)
+		}
+	}
+
+	return diagnostics
+}
+
+// withSchemas returns the metadata with the schemas configured on the handler applied to it
+func withSchemas(md map[string]data.TypedValue, schemas *trigger.SchemaConfig) map[string]data.TypedValue {
+	if md == nil || schemas == nil || len(schemas.Output) == 0 {
+		return md
+	}
+
+	withSchemas := make(map[string]data.TypedValue, len(md))
+	for name, tv := range md {
+		if s, ok := schemas.Output[name].(schema.Schema); ok {
+			withSchemas[name] = data.NewAttributeWithSchema(name, tv.Type(), tv.Value(), s)
+		} else {
+			withSchemas[name] = tv
+		}
+	}
+	return withSchemas
+}
+
+// diagnosticsError consolidates the diagnostics in to a single error
+func diagnosticsError(diagnostics []*expression.Diagnostic) error {
+	msgs := make([]string, len(diagnostics))
+	for i, d := range diagnostics {
+		msgs[i] = d.Error()
+	}
+	return fmt.Errorf("mapping check failed:\n\t%s", strings.Join(msgs, "\n\t"))
+}
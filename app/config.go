@@ -1,12 +1,14 @@
 package app
 
 import (
+	"encoding/json"
 	"os"
 	"strconv"
 
 	"github.com/project-flogo/core/action"
 	"github.com/project-flogo/core/app/resource"
 	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/property"
 	"github.com/project-flogo/core/data/schema"
 	"github.com/project-flogo/core/support/connection"
 	"github.com/project-flogo/core/trigger"
@@ -33,6 +35,90 @@ type Config struct {
 	Actions     []*action.Config              `json:"actions,omitempty"`
 	Schemas     map[string]*schema.Def        `json:"schemas,omitempty"`
 	Connections map[string]*connection.Config `json:"connections,omitempty"`
+
+	// PropertyConstraints are the constraints of the properties, keyed by property name.  They are specified
+	// along with the property, ex. {"name": "port", "type": "int", "value": 8080, "min": 1, "max": 65535}
+	PropertyConstraints map[string]*property.Constraints `json:"-"`
+}
+
+type configAlias Config
+
+// UnmarshalJSON implements json.Unmarshaler.UnmarshalJSON
+func (c *Config) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*configAlias)(c)); err != nil {
+		return err
+	}
+
+	ser := &struct {
+		Properties []*struct {
+			Name string `json:"name"`
+			property.Constraints
+		} `json:"properties,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, ser); err != nil {
+		return err
+	}
+
+	c.PropertyConstraints = nil
+	for _, p := range ser.Properties {
+		if p != nil && !p.Constraints.IsEmpty() {
+			if c.PropertyConstraints == nil {
+				c.PropertyConstraints = make(map[string]*property.Constraints)
+			}
+			constraints := p.Constraints
+			c.PropertyConstraints[p.Name] = &constraints
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.MarshalJSON
+func (c Config) MarshalJSON() ([]byte, error) {
+	if len(c.PropertyConstraints) == 0 {
+		return json.Marshal(configAlias(c))
+	}
+
+	properties := make([]interface{}, 0, len(c.Properties))
+	for _, attr := range c.Properties {
+		constraints := c.PropertyConstraints[attr.Name()]
+		if constraints.IsEmpty() {
+			properties = append(properties, attr)
+			continue
+		}
+
+		// add the constraints to the serialized attribute
+		attrJson, err := json.Marshal(attr)
+		if err != nil {
+			return nil, err
+		}
+		constraintsJson, err := json.Marshal(constraints)
+		if err != nil {
+			return nil, err
+		}
+		p := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(attrJson, &p); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(constraintsJson, &p); err != nil {
+			return nil, err
+		}
+		properties = append(properties, p)
+	}
+
+	alias := configAlias(c)
+	return json.Marshal(&struct {
+		*configAlias
+		Properties []interface{} `json:"properties,omitempty"`
+	}{configAlias: &alias, Properties: properties})
+}
+
+// PropertyDefinitions returns the definitions of the properties, used to validate their values
+func (c *Config) PropertyDefinitions() []*property.Definition {
+	defs := make([]*property.Definition, 0, len(c.Properties))
+	for _, attr := range c.Properties {
+		defs = append(defs, &property.Definition{Name: attr.Name(), Type: attr.Type(), Constraints: c.PropertyConstraints[attr.Name()]})
+	}
+	return defs
 }
 
 func GetDelayedStopInterval() string {
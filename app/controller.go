@@ -113,3 +113,81 @@ func (c *controllerData) pauseTriggers() error {
 	}
 	return nil
 }
+
+// triggerFlowController pauses and resumes individual triggers on behalf of their handlers, a trigger
+// paused by several handlers is only resumed once all of them have resumed it
+type triggerFlowController struct {
+	lock     sync.Mutex
+	triggers map[string]trigger.Trigger
+	paused   map[string]int
+}
+
+func (a *App) newTriggerFlowController() *triggerFlowController {
+	fc := &triggerFlowController{triggers: make(map[string]trigger.Trigger), paused: make(map[string]int)}
+	for _, trgW := range a.triggers {
+		fc.triggers[trgW.id] = trgW.trg
+	}
+	return fc
+}
+
+// PauseTrigger implements trigger.FlowController.PauseTrigger
+func (c *triggerFlowController) PauseTrigger(triggerId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	flowControlAware, err := c.flowControlAware(triggerId)
+	if err != nil {
+		return err
+	}
+
+	if c.paused[triggerId] == 0 {
+		err = flowControlAware.Pause()
+		if err != nil {
+			return err
+		}
+		logger.Infof("Trigger [%s] is paused.", triggerId)
+	}
+	c.paused[triggerId]++
+
+	return nil
+}
+
+// ResumeTrigger implements trigger.FlowController.ResumeTrigger
+func (c *triggerFlowController) ResumeTrigger(triggerId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	flowControlAware, err := c.flowControlAware(triggerId)
+	if err != nil {
+		return err
+	}
+
+	if c.paused[triggerId] == 0 {
+		return nil
+	}
+
+	if c.paused[triggerId] == 1 {
+		err = flowControlAware.Resume()
+		if err != nil {
+			return err
+		}
+		logger.Infof("Trigger [%s] is resumed.", triggerId)
+	}
+	c.paused[triggerId]--
+
+	return nil
+}
+
+func (c *triggerFlowController) flowControlAware(triggerId string) (trigger.EventFlowControlAware, error) {
+	trg, exists := c.triggers[triggerId]
+	if !exists {
+		return nil, fmt.Errorf("unknown trigger: %s", triggerId)
+	}
+
+	flowControlAware, ok := trg.(trigger.EventFlowControlAware)
+	if !ok {
+		return nil, fmt.Errorf("trigger [%s] does not support flow control", triggerId)
+	}
+
+	return flowControlAware, nil
+}
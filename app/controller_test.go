@@ -1,6 +1,7 @@
 package app
 
 import (
+	"github.com/project-flogo/core/trigger"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -23,3 +24,46 @@ func TestController(t *testing.T) {
 	err = c.ReleaseControl()
 	assert.Nil(t, err)
 }
+
+type flowControlTrigger struct {
+	paused bool
+}
+
+func (t *flowControlTrigger) Initialize(ctx trigger.InitContext) error {
+	return nil
+}
+
+func (t *flowControlTrigger) Start() error {
+	return nil
+}
+
+func (t *flowControlTrigger) Stop() error {
+	return nil
+}
+
+func (t *flowControlTrigger) Pause() error {
+	t.paused = true
+	return nil
+}
+
+func (t *flowControlTrigger) Resume() error {
+	t.paused = false
+	return nil
+}
+
+func TestTriggerFlowController(t *testing.T) {
+	trg := &flowControlTrigger{}
+	testApp := &App{triggers: []*triggerWrapper{{id: "trg", trg: trg}}}
+	fc := testApp.newTriggerFlowController()
+
+	// the trigger stays paused until every pause is resumed
+	assert.Nil(t, fc.PauseTrigger("trg"))
+	assert.Nil(t, fc.PauseTrigger("trg"))
+	assert.True(t, trg.paused)
+	assert.Nil(t, fc.ResumeTrigger("trg"))
+	assert.True(t, trg.paused)
+	assert.Nil(t, fc.ResumeTrigger("trg"))
+	assert.False(t, trg.paused)
+
+	assert.NotNil(t, fc.PauseTrigger("unknown"))
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/trigger"
+)
+
+// DrainReport describes the outcome of draining the app
+type DrainReport struct {
+	// Completed indicates if all in-flight events completed before the timeout
+	Completed bool
+	// Pending is the number of events still in-flight when the timeout expired, keyed by "<trigger id>/<handler name>"
+	Pending map[string]int64
+	// Duration is the time taken to drain
+	Duration time.Duration
+}
+
+// String returns a human readable description of the report
+func (r *DrainReport) String() string {
+	if r.Completed {
+		return "all in-flight events completed in " + r.Duration.String()
+	}
+
+	var names []string
+	for name := range r.Pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("timed out after " + r.Duration.String() + ", events still in-flight: ")
+	for i, name := range names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(name + "=" + strconv.FormatInt(r.Pending[name], 10))
+	}
+	return b.String()
+}
+
+// Drain pauses all the triggers that support flow control, stops handlers from accepting new events
+// and waits until the in-flight events complete or the timeout expires
+func (a *App) Drain(timeout time.Duration) *DrainReport {
+
+	logger := log.RootLogger()
+	logger.Infof("Draining application, waiting up to %s for in-flight events to complete...", timeout)
+
+	start := time.Now()
+
+	a.mutex.RLock()
+	triggers := a.triggers
+	a.mutex.RUnlock()
+
+	for _, trgW := range triggers {
+		if flowControlAware, ok := trgW.trg.(trigger.EventFlowControlAware); ok {
+			if err := flowControlAware.Pause(); err != nil {
+				logger.Warnf("Unable to pause trigger [%s] while draining: %s", trgW.id, err.Error())
+			}
+		}
+	}
+
+	trigger.Drain()
+
+	pending := trigger.WaitForInFlight(timeout)
+	report := &DrainReport{Completed: len(pending) == 0, Pending: pending, Duration: time.Since(start)}
+
+	if report.Completed {
+		logger.Infof("Application drained, %s", report)
+	} else {
+		logger.Warnf("Application drain incomplete, %s", report)
+	}
+
+	return report
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/project-flogo/core/support/connection"
+	"github.com/project-flogo/core/support/health"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/managed"
+)
+
+// registerHealthChecks registers readiness checks for the triggers, connection managers and resources of the app
+func (a *App) registerHealthChecks() {
+
+	for _, trgW := range a.triggers {
+		trgW := trgW
+		a.registerHealthCheck("trigger:"+trgW.id, health.CheckerFunc(func(ctx context.Context) error {
+			status := trgW.GetStatus()
+			if status.Status != managed.StatusStarted {
+				if status.Error != nil {
+					return fmt.Errorf("trigger [%s] is %s: %s", trgW.id, status.Status, status.Error.Error())
+				}
+				return fmt.Errorf("trigger [%s] is %s", trgW.id, status.Status)
+			}
+			if checker, ok := trgW.trg.(health.Checker); ok {
+				return checker.HealthCheck(ctx)
+			}
+			return nil
+		}))
+	}
+
+	for id, manager := range connection.Managers() {
+		if checker, ok := manager.(health.Checker); ok {
+			a.registerHealthCheck("connection:"+id, checker)
+		}
+	}
+
+	for id, res := range a.resManager.Resources() {
+		if checker, ok := res.Object().(health.Checker); ok {
+			a.registerHealthCheck("resource:"+id, checker)
+		}
+	}
+}
+
+func (a *App) registerHealthCheck(name string, checker health.Checker) {
+	err := health.Register(name, checker, health.Readiness)
+	if err != nil {
+		log.RootLogger().Warnf("Unable to register health check '%s': %s", name, err.Error())
+		return
+	}
+	a.healthChecks = append(a.healthChecks, name)
+}
+
+// unregisterHealthChecks removes the health checks registered by the app
+func (a *App) unregisterHealthChecks() {
+	for _, name := range a.healthChecks {
+		health.Unregister(name)
+	}
+	a.healthChecks = nil
+}
+
+// updateHealth flips the aggregated health state based on the app status
+func updateHealth(appStatus Status) {
+	switch appStatus {
+	case STARTED:
+		health.SetLive(true)
+		health.SetReady(true)
+	case FAILED:
+		health.SetLive(false)
+		health.SetReady(false)
+	default:
+		health.SetReady(false)
+	}
+}
@@ -218,6 +218,11 @@ func (a *App) createTriggers(tConfigs []*trigger.Config, runner action.Runner) (
 				}
 			}
 
+			err = checkHandlerMappings(tConfig.Id, triggerFactory.Metadata(), hConfig, acts, expressionFactory)
+			if err != nil {
+				return nil, fmt.Errorf("error creating handler [%s] in trigger [%s]:%s", hConfig.Name, tConfig.Id, err.Error())
+			}
+
 			handler, err := trigger.NewHandler(hConfig, acts, mapperFactory, expressionFactory, runner, logger)
 			if err != nil {
 				return nil, fmt.Errorf("error creating handler [%s] in trigger [%s]:%s", hConfig.Name, tConfig.Id, err.Error())
@@ -337,6 +342,11 @@ func (a *App) reconfigureTriggers(tConfigs []*trigger.Config, runner action.Runn
 							}
 						}
 					}
+					checkErr := checkHandlerMappings(tConfig.Id, triggerFactory.Metadata(), hConfig, acts, expressionFactory)
+					if checkErr != nil {
+						err = fmt.Errorf("error creating handler [%s] in trigger [%s]:%s", hConfig.Name, tConfig.Id, checkErr.Error())
+						return
+					}
 					handler, handlerErr := trigger.NewHandler(hConfig, acts, mapperFactory, expressionFactory, runner, logger)
 					if handlerErr != nil {
 						err = fmt.Errorf("error creating handler [%s] in trigger [%s]:%s", hConfig.Name, tConfig.Id, handlerErr.Error())
@@ -0,0 +1,110 @@
+package propertyresolver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-flogo/core/data/property"
+	"github.com/project-flogo/core/support/log"
+)
+
+// Comma separated list of directories containing a file per property, the name of the file is the name of the
+// property and its content is the value (e.g. a mounted Kubernetes ConfigMap or Secret), later directories take
+// precedence.  e.g. FLOGO_APP_PROPS_DIR=/etc/config,/etc/secrets
+const EnvAppPropertyDirConfigKey = "FLOGO_APP_PROPS_DIR"
+const ResolverNameDir = "dir"
+
+func init() {
+
+	logger := log.RootLogger()
+
+	dirPaths := os.Getenv(EnvAppPropertyDirConfigKey)
+	if dirPaths != "" {
+		resolver, err := NewDirValueResolver(os.Getenv(EnvKeyProfile), strings.Split(dirPaths, ",")...)
+		if err != nil {
+			logger.Errorf("Can not load property directories - %s due to error - %v", dirPaths, err)
+			panic("")
+		}
+		_ = property.RegisterExternalResolver(resolver)
+	}
+}
+
+// DirValueResolver resolves property values from directories containing a file per property.  If a profile is set,
+// the files in the <profile> sub-directory of each directory take precedence.
+type DirValueResolver struct {
+	values  map[string]interface{}
+	sources map[string]string
+}
+
+// NewDirValueResolver creates a resolver for the directories, later directories take precedence
+func NewDirValueResolver(profile string, dirs ...string) (*DirValueResolver, error) {
+	resolver := &DirValueResolver{values: make(map[string]interface{}), sources: make(map[string]string)}
+
+	for _, dir := range dirs {
+		dir = strings.TrimSpace(dir)
+		if dir == "" {
+			continue
+		}
+		if err := resolver.load(dir); err != nil {
+			return nil, err
+		}
+
+		if profile != "" {
+			overlay := filepath.Join(dir, profile)
+			if info, err := os.Stat(overlay); err == nil && info.IsDir() {
+				if err := resolver.load(overlay); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return resolver, nil
+}
+
+func (resolver *DirValueResolver) load(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		// hidden files are skipped, Kubernetes mounts include ..data and ..<timestamp> entries
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		// follow symlinks, mounted files are usually links
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		resolver.values[entry.Name()] = strings.TrimRight(string(data), "\r\n")
+		resolver.sources[entry.Name()] = path
+	}
+	return nil
+}
+
+func (resolver *DirValueResolver) Name() string {
+	return ResolverNameDir
+}
+
+func (resolver *DirValueResolver) LookupValue(key string) (interface{}, bool) {
+	val, found := resolver.values[key]
+	return val, found
+}
+
+// LookupSource returns the file the value of the property was read from
+func (resolver *DirValueResolver) LookupSource(key string) (string, bool) {
+	src, found := resolver.sources[key]
+	return src, found
+}
@@ -0,0 +1,112 @@
+package propertyresolver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-flogo/core/data/property"
+	"github.com/project-flogo/core/support/log"
+)
+
+// Comma separated list of property files overriding default application property values, the format of a file is
+// based on its extension (.yaml, .yml, .properties, .json or .env), later files take precedence.
+// e.g. FLOGO_APP_PROPS_FILES=app.yaml,.env
+const EnvAppPropertyFilesConfigKey = "FLOGO_APP_PROPS_FILES"
+const ResolverNameFile = "file"
+
+// EnvKeyProfile selects the profile overlays applied on top of the property files and directories (e.g. prod)
+const EnvKeyProfile = "FLOGO_ENV"
+
+func init() {
+
+	logger := log.RootLogger()
+
+	filePaths := os.Getenv(EnvAppPropertyFilesConfigKey)
+	if filePaths != "" {
+		resolver, err := NewFileValueResolver(os.Getenv(EnvKeyProfile), strings.Split(filePaths, ",")...)
+		if err != nil {
+			logger.Errorf("Can not load property files - %s due to error - %v", filePaths, err)
+			panic("")
+		}
+		_ = property.RegisterExternalResolver(resolver)
+	}
+}
+
+// FileValueResolver resolves property values from property files.  If a profile is set, the profile overlay of each
+// file is applied on top of it, app-<profile>.yaml for app.yaml or .env.<profile> for .env
+type FileValueResolver struct {
+	values  map[string]interface{}
+	sources map[string]string
+}
+
+// NewFileValueResolver creates a resolver for the property files, later files take precedence
+func NewFileValueResolver(profile string, files ...string) (*FileValueResolver, error) {
+	resolver := &FileValueResolver{values: make(map[string]interface{}), sources: make(map[string]string)}
+
+	for _, file := range files {
+		file = strings.TrimSpace(file)
+		if file == "" {
+			continue
+		}
+		if err := resolver.load(file); err != nil {
+			return nil, err
+		}
+
+		if profile != "" {
+			overlay := ProfileFile(file, profile)
+			if _, err := os.Stat(overlay); err == nil {
+				if err := resolver.load(overlay); err != nil {
+					return nil, err
+				}
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+
+	return resolver, nil
+}
+
+func (resolver *FileValueResolver) load(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	props, err := parsePropertyFile(file, data)
+	if err != nil {
+		return fmt.Errorf("unable to parse '%s': %s", file, err.Error())
+	}
+	for k, v := range props {
+		resolver.values[k] = v
+		resolver.sources[k] = file
+	}
+	return nil
+}
+
+func (resolver *FileValueResolver) Name() string {
+	return ResolverNameFile
+}
+
+func (resolver *FileValueResolver) LookupValue(key string) (interface{}, bool) {
+	val, found := resolver.values[key]
+	return val, found
+}
+
+// LookupSource returns the file the value of the property was loaded from
+func (resolver *FileValueResolver) LookupSource(key string) (string, bool) {
+	src, found := resolver.sources[key]
+	return src, found
+}
+
+// ProfileFile returns the profile overlay of a property file, ex. app-prod.yaml for app.yaml or .env.prod for .env
+func ProfileFile(file, profile string) string {
+	dir, name := filepath.Split(file)
+	ext := filepath.Ext(name)
+	if ext == name || ext == "" {
+		// a file such as .env
+		return file + "." + profile
+	}
+	return filepath.Join(dir, strings.TrimSuffix(name, ext)+"-"+profile+ext)
+}
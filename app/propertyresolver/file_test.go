@@ -0,0 +1,183 @@
+package propertyresolver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseYaml(t *testing.T) {
+	props, err := parseYaml([]byte(`---
+# database
+db:
+  url: "postgres://localhost:5432/db" # the url
+  port: 5432
+  pool: {size: 10}
+  ssl: false
+name: it's mine
+id: "007"
+empty:
+hosts:
+- a.example.com
+- 'b.example.com'
+levels: [debug, "info"]
+servers:
+- name: a
+  port: 80
+cert: |
+  line1
+  line2
+description: >-
+  folded
+  text
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"db.url":       "postgres://localhost:5432/db",
+		"db.port":      5432,
+		"db.pool.size": 10,
+		"db.ssl":       false,
+		"name":         "it's mine",
+		"id":           "007",
+		"empty":        nil,
+		"hosts":        []interface{}{"a.example.com", "b.example.com"},
+		"levels":       []interface{}{"debug", "info"},
+		"servers":      []interface{}{map[string]interface{}{"name": "a", "port": 80}},
+		"cert":         "line1\nline2\n",
+		"description":  "folded text",
+	}, props)
+
+	props, err = parseYaml([]byte("# nothing"))
+	assert.Nil(t, err)
+	assert.Empty(t, props)
+
+	for _, invalid := range []string{"- a", "a:\n\tb: 1", "just text", "a: [b"} {
+		_, err = parseYaml([]byte(invalid))
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestParseDotEnvAndProperties(t *testing.T) {
+	props, err := parseDotEnv([]byte(`# comment
+export DB_USER=admin
+DB_URL="postgres://localhost:5432/db\n"
+DB_PASSWORD='p#ss'
+LEVEL=info # comment
+QUOTED="x y" # comment
+SINGLE='x' #comment
+HASH="a # b" # comment
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"DB_USER":     "admin",
+		"DB_URL":      "postgres://localhost:5432/db\n",
+		"DB_PASSWORD": "p#ss",
+		"LEVEL":       "info",
+		"QUOTED":      "x y",
+		"SINGLE":      "x",
+		"HASH":        "a # b",
+	}, props)
+
+	_, err = parseDotEnv([]byte("INVALID"))
+	assert.NotNil(t, err)
+
+	props, err = parseProperties([]byte(`# comment
+! comment
+db.url = postgres://localhost:5432/db
+db.user: admin
+db.hosts a.example.com, \
+         b.example.com
+path\ with\ spaces=c:\\temp
+unicode=\u00e9
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"db.url":           "postgres://localhost:5432/db",
+		"db.user":          "admin",
+		"db.hosts":         "a.example.com, b.example.com",
+		"path with spaces": `c:\temp`,
+		"unicode":          "é",
+	}, props)
+}
+
+func TestFileValueResolver(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "app.yaml"), "db:\n  url: dev-url\n  user: dev\n")
+	writeFile(t, filepath.Join(dir, "app-prod.yaml"), "db:\n  url: prod-url\n")
+	writeFile(t, filepath.Join(dir, ".env"), "db.user=env-user\n")
+	writeFile(t, filepath.Join(dir, ".env.test"), "db.user=test-user\n")
+
+	files := []string{filepath.Join(dir, "app.yaml"), filepath.Join(dir, ".env")}
+
+	resolver, err := NewFileValueResolver("", files...)
+	assert.Nil(t, err)
+	val, found := resolver.LookupValue("db.url")
+	assert.True(t, found)
+	assert.Equal(t, "dev-url", val)
+	// later files take precedence
+	val, _ = resolver.LookupValue("db.user")
+	assert.Equal(t, "env-user", val)
+	source, _ := resolver.LookupSource("db.user")
+	assert.Equal(t, filepath.Join(dir, ".env"), source)
+
+	resolver, err = NewFileValueResolver("prod", files...)
+	assert.Nil(t, err)
+	val, _ = resolver.LookupValue("db.url")
+	assert.Equal(t, "prod-url", val)
+	source, _ = resolver.LookupSource("db.url")
+	assert.Equal(t, filepath.Join(dir, "app-prod.yaml"), source)
+	val, _ = resolver.LookupValue("db.user")
+	assert.Equal(t, "env-user", val)
+
+	resolver, err = NewFileValueResolver("test", files...)
+	assert.Nil(t, err)
+	val, _ = resolver.LookupValue("db.user")
+	assert.Equal(t, "test-user", val)
+
+	_, found = resolver.LookupValue("missing")
+	assert.False(t, found)
+
+	_, err = NewFileValueResolver("", filepath.Join(dir, "missing.yaml"))
+	assert.NotNil(t, err)
+	writeFile(t, filepath.Join(dir, "app.ini"), "a=b")
+	_, err = NewFileValueResolver("", filepath.Join(dir, "app.ini"))
+	assert.NotNil(t, err)
+}
+
+func TestDirValueResolver(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "db.url"), "dev-url\n")
+	writeFile(t, filepath.Join(dir, "db.user"), "dev")
+	writeFile(t, filepath.Join(dir, "..data"), "ignored")
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "prod"), 0755))
+	writeFile(t, filepath.Join(dir, "prod", "db.url"), "prod-url")
+
+	resolver, err := NewDirValueResolver("", dir)
+	assert.Nil(t, err)
+	val, found := resolver.LookupValue("db.url")
+	assert.True(t, found)
+	assert.Equal(t, "dev-url", val)
+	_, found = resolver.LookupValue("..data")
+	assert.False(t, found)
+	_, found = resolver.LookupValue("prod")
+	assert.False(t, found)
+
+	resolver, err = NewDirValueResolver("prod", dir)
+	assert.Nil(t, err)
+	val, _ = resolver.LookupValue("db.url")
+	assert.Equal(t, "prod-url", val)
+	source, _ := resolver.LookupSource("db.url")
+	assert.Equal(t, filepath.Join(dir, "prod", "db.url"), source)
+	val, _ = resolver.LookupValue("db.user")
+	assert.Equal(t, "dev", val)
+
+	_, err = NewDirValueResolver("", filepath.Join(dir, "missing"))
+	assert.NotNil(t, err)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	err := os.WriteFile(path, []byte(content), 0644)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,243 @@
+package propertyresolver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// parsePropertyFile parses a property file based on its extension, nested keys are flattened using '.' (ex. db.url)
+func parsePropertyFile(path string, data []byte) (map[string]interface{}, error) {
+	name := filepath.Base(path)
+	ext := strings.ToLower(filepath.Ext(name))
+
+	switch {
+	case ext == ".yaml" || ext == ".yml":
+		return parseYaml(data)
+	case ext == ".properties":
+		return parseProperties(data)
+	case ext == ".json":
+		props := make(map[string]interface{})
+		err := json.Unmarshal(data, &props)
+		return props, err
+	case ext == ".env" || strings.HasPrefix(name, ".env"):
+		return parseDotEnv(data)
+	}
+	return nil, fmt.Errorf("unsupported property file format '%s'", name)
+}
+
+// parseDotEnv parses a .env file, ex.
+//
+//	# comment
+//	export DB_USER=admin
+//	DB_URL="postgres://localhost:5432/db"
+func parseDotEnv(data []byte) (map[string]interface{}, error) {
+	props := make(map[string]interface{})
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		idx := strings.IndexByte(line, '=')
+		if idx <= 0 {
+			return nil, fmt.Errorf("line %d: expected KEY=value", num)
+		}
+		key := strings.TrimSpace(line[:idx])
+		value := strings.TrimSpace(line[idx+1:])
+		if value != "" && (value[0] == '"' || value[0] == '\'') {
+			// a comment can follow the closing quote, ex. KEY="value" # comment
+			value = stripComment(value, '#', true)
+		}
+
+		switch {
+		case len(value) > 1 && value[0] == '"' && value[len(value)-1] == '"':
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid quoted value: %s", num, err.Error())
+			}
+			value = unquoted
+		case len(value) > 1 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		default:
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = strings.TrimSpace(value[:idx])
+			}
+		}
+		props[key] = value
+	}
+	return props, scanner.Err()
+}
+
+// parseProperties parses a java style .properties file
+func parseProperties(data []byte) (map[string]interface{}, error) {
+	props := make(map[string]interface{})
+
+	var logical strings.Builder
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if logical.Len() == 0 && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+
+		// a line ending with an odd number of backslashes continues on the next line
+		trailing := len(line) - len(strings.TrimRight(line, "\\"))
+		if trailing%2 == 1 {
+			logical.WriteString(line[:len(line)-1])
+			continue
+		}
+		logical.WriteString(line)
+
+		key, value := splitProperty(logical.String())
+		logical.Reset()
+		props[unescapeProperty(key)] = unescapeProperty(value)
+	}
+	if logical.Len() > 0 {
+		key, value := splitProperty(logical.String())
+		props[unescapeProperty(key)] = unescapeProperty(value)
+	}
+	return props, scanner.Err()
+}
+
+// splitProperty splits a property at the first unescaped '=', ':' or whitespace
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return strings.TrimSpace(line[:i]), strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if len(rest) > 0 && (rest[0] == '=' || rest[0] == ':') {
+				rest = strings.TrimLeft(rest[1:], " \t\f")
+			}
+			return line[:i], rest
+		}
+	}
+	return line, ""
+}
+
+func unescapeProperty(s string) string {
+	if !strings.ContainsRune(s, '\\') {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i == len(s)-1 {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					sb.WriteRune(rune(r))
+					i += 4
+					continue
+				}
+			}
+			sb.WriteByte('u')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+// parseYaml parses a YAML file, mappings are flattened using '.' (ex. db: {url: ...} is db.url)
+func parseYaml(data []byte) (map[string]interface{}, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	props := make(map[string]interface{})
+	switch doc.(type) {
+	case nil:
+		return props, nil
+	case map[interface{}]interface{}:
+		flattenYaml("", doc, props)
+		return props, nil
+	}
+	return nil, fmt.Errorf("expected a mapping of properties")
+}
+
+func flattenYaml(key string, value interface{}, props map[string]interface{}) {
+	m, ok := value.(map[interface{}]interface{})
+	if !ok || (len(m) == 0 && key != "") {
+		props[key] = yamlValue(value)
+		return
+	}
+	for k, v := range m {
+		name := fmt.Sprint(k)
+		if key != "" {
+			name = key + "." + name
+		}
+		flattenYaml(name, v, props)
+	}
+}
+
+// yamlValue converts the mappings of a value to map[string]interface{}, as they are for json values
+func yamlValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = yamlValue(item)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, item := range v {
+			arr[i] = yamlValue(item)
+		}
+		return arr
+	}
+	return value
+}
+
+// stripComment removes a comment from a line, if whitespaceBefore is set the comment must be preceded by whitespace
+func stripComment(line string, marker byte, whitespaceBefore bool) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.IndexByte(" \t:=[{,-", line[i-1]) >= 0):
+			// only a quote at the start of a value starts a quoted string, ex. it's is not quoted
+			quote = c
+		case c == marker:
+			if !whitespaceBefore || i == 0 || line[i-1] == ' ' || line[i-1] == '\t' {
+				return strings.TrimRight(line[:i], " \t\r")
+			}
+		}
+	}
+	return strings.TrimRight(line, " \t\r")
+}
@@ -0,0 +1,467 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/project-flogo/core/app/resource"
+	"github.com/project-flogo/core/data/property"
+	"github.com/project-flogo/core/support/connection"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/trigger"
+)
+
+// ChangeType is the type of change made to an element of the app configuration
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+const (
+	KindProperty   = "property"
+	KindTrigger    = "trigger"
+	KindHandler    = "handler"
+	KindResource   = "resource"
+	KindConnection = "connection"
+	KindAction     = "action"
+	KindSchema     = "schema"
+	KindChannel    = "channel"
+	KindImport     = "import"
+)
+
+// Change describes a single difference between two app configurations
+type Change struct {
+	Kind string     `json:"kind"`
+	ID   string     `json:"id"`
+	Type ChangeType `json:"type"`
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s [%s] %s", c.Kind, c.ID, c.Type)
+}
+
+// ConfigDiff is the set of differences between two app configurations
+type ConfigDiff struct {
+	Changes []*Change `json:"changes"`
+}
+
+// IsEmpty indicates if there are no differences
+func (d *ConfigDiff) IsEmpty() bool {
+	return d == nil || len(d.Changes) == 0
+}
+
+// Get returns the changes of the specified kind
+func (d *ConfigDiff) Get(kind string) []*Change {
+	var changes []*Change
+	for _, change := range d.Changes {
+		if change.Kind == kind {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func (d *ConfigDiff) String() string {
+	if d.IsEmpty() {
+		return "no changes"
+	}
+	parts := make([]string, 0, len(d.Changes))
+	for _, change := range d.Changes {
+		parts = append(parts, change.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (d *ConfigDiff) add(kind, id string, changeType ChangeType) {
+	d.Changes = append(d.Changes, &Change{Kind: kind, ID: id, Type: changeType})
+}
+
+// DiffConfig compares two app configurations and returns the differences
+func DiffConfig(oldConfig, newConfig *Config) *ConfigDiff {
+
+	diff := &ConfigDiff{}
+
+	oldProps := make(map[string]interface{}, len(oldConfig.Properties))
+	for _, attr := range oldConfig.Properties {
+		oldProps[attr.Name()] = attr
+	}
+	newProps := make(map[string]interface{}, len(newConfig.Properties))
+	for _, attr := range newConfig.Properties {
+		newProps[attr.Name()] = attr
+	}
+	diffMaps(diff, KindProperty, oldProps, newProps)
+
+	oldTriggers := make(map[string]*trigger.Config, len(oldConfig.Triggers))
+	for _, tConfig := range oldConfig.Triggers {
+		oldTriggers[tConfig.Id] = tConfig
+	}
+	newTriggers := make(map[string]*trigger.Config, len(newConfig.Triggers))
+	for _, tConfig := range newConfig.Triggers {
+		newTriggers[tConfig.Id] = tConfig
+	}
+	for _, id := range sortedKeys(oldTriggers, newTriggers) {
+		oldTrg, inOld := oldTriggers[id]
+		newTrg, inNew := newTriggers[id]
+		switch {
+		case !inNew:
+			diff.add(KindTrigger, id, ChangeRemoved)
+		case !inOld:
+			diff.add(KindTrigger, id, ChangeAdded)
+		default:
+			oldCopy, newCopy := *oldTrg, *newTrg
+			oldCopy.Handlers, newCopy.Handlers = nil, nil
+			if !equalJSON(&oldCopy, &newCopy) {
+				diff.add(KindTrigger, id, ChangeModified)
+			}
+			diffMaps(diff, KindHandler, handlersByName(oldTrg), handlersByName(newTrg))
+		}
+	}
+
+	oldResources := make(map[string]interface{}, len(oldConfig.Resources))
+	for _, resConfig := range oldConfig.Resources {
+		oldResources[resConfig.ID] = resConfig
+	}
+	newResources := make(map[string]interface{}, len(newConfig.Resources))
+	for _, resConfig := range newConfig.Resources {
+		newResources[resConfig.ID] = resConfig
+	}
+	diffMaps(diff, KindResource, oldResources, newResources)
+
+	oldConnections := make(map[string]interface{}, len(oldConfig.Connections))
+	for id, connConfig := range oldConfig.Connections {
+		oldConnections[id] = connConfig
+	}
+	newConnections := make(map[string]interface{}, len(newConfig.Connections))
+	for id, connConfig := range newConfig.Connections {
+		newConnections[id] = connConfig
+	}
+	diffMaps(diff, KindConnection, oldConnections, newConnections)
+
+	oldActions := make(map[string]interface{}, len(oldConfig.Actions))
+	for _, actConfig := range oldConfig.Actions {
+		oldActions[actConfig.Id] = actConfig
+	}
+	newActions := make(map[string]interface{}, len(newConfig.Actions))
+	for _, actConfig := range newConfig.Actions {
+		newActions[actConfig.Id] = actConfig
+	}
+	diffMaps(diff, KindAction, oldActions, newActions)
+
+	oldSchemas := make(map[string]interface{}, len(oldConfig.Schemas))
+	for id, def := range oldConfig.Schemas {
+		oldSchemas[id] = def
+	}
+	newSchemas := make(map[string]interface{}, len(newConfig.Schemas))
+	for id, def := range newConfig.Schemas {
+		newSchemas[id] = def
+	}
+	diffMaps(diff, KindSchema, oldSchemas, newSchemas)
+
+	diffMaps(diff, KindChannel, toSet(oldConfig.Channels), toSet(newConfig.Channels))
+	diffMaps(diff, KindImport, toSet(oldConfig.Imports), toSet(newConfig.Imports))
+
+	return diff
+}
+
+// ApplyConfig applies the differences between the running configuration and the specified configuration.
+// Only properties, triggers, handlers, resources and connections can be changed without restarting the app,
+// if any of the changes fail to apply the previous configuration is restored.
+func (a *App) ApplyConfig(newConfig *Config) (*ConfigDiff, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !a.started {
+		return nil, fmt.Errorf("app is not started")
+	}
+
+	if a.config == nil {
+		return nil, fmt.Errorf("original app configuration not available")
+	}
+
+	oldConfig := &Config{}
+	err := json.Unmarshal(a.config, oldConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	newConfigJson, err := json.Marshal(newConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := DiffConfig(oldConfig, newConfig)
+	if diff.IsEmpty() {
+		return diff, nil
+	}
+
+	err = a.validateChanges(diff)
+	if err != nil {
+		return diff, err
+	}
+
+	logger := log.RootLogger()
+
+	var rollbacks []func() error
+	apply := func(name string, doApply func(cfg *Config) error) error {
+		// register the rollback first, a failed apply might have partially been applied
+		rollbacks = append(rollbacks, func() error {
+			// get a fresh copy, applying modifies the configuration
+			orig := &Config{}
+			if err := json.Unmarshal(a.config, orig); err != nil {
+				return err
+			}
+			return doApply(orig)
+		})
+		err := doApply(newConfig)
+		if err != nil {
+			return fmt.Errorf("failed to apply %s changes: %s", name, err.Error())
+		}
+		return nil
+	}
+
+	if len(diff.Get(KindProperty)) > 0 {
+		err = apply(KindProperty, a.applyProperties)
+	}
+	if err == nil && len(diff.Get(KindConnection)) > 0 {
+		err = apply(KindConnection, func(cfg *Config) error {
+			return connection.ReconfigureConnections(selectConnections(cfg, diff))
+		})
+	}
+	if err == nil && len(diff.Get(KindResource)) > 0 {
+		err = apply(KindResource, func(cfg *Config) error {
+			return a.resManager.ReconfigureResources(selectResources(cfg, diff))
+		})
+	}
+	if err == nil && (len(diff.Get(KindTrigger)) > 0 || len(diff.Get(KindHandler)) > 0) {
+		err = apply(KindTrigger, func(cfg *Config) error {
+			return a.reconfigureTriggers(selectTriggers(cfg, diff), a.actionRunner)
+		})
+	}
+
+	if err != nil {
+		logger.Errorf("Unable to apply app configuration changes [%s]: %s", diff, err.Error())
+		for i := len(rollbacks) - 1; i >= 0; i-- {
+			if rbErr := rollbacks[i](); rbErr != nil {
+				logger.Errorf("Unable to rollback app configuration changes: %s", rbErr.Error())
+			}
+		}
+		return diff, err
+	}
+
+	a.config = newConfigJson
+	logger.Infof("App configuration changes applied: %s", diff)
+
+	return diff, nil
+}
+
+func (a *App) validateChanges(diff *ConfigDiff) error {
+
+	var unsupported []string
+
+	for _, change := range diff.Changes {
+		switch change.Kind {
+		case KindProperty:
+			continue
+		case KindHandler:
+			// handlers are recreated when the trigger is reconfigured
+			if tw := a.getTrigger(triggerIdFromHandler(change.ID)); tw != nil {
+				if _, ok := tw.trg.(trigger.ReconfigurableTrigger); ok {
+					continue
+				}
+			}
+		case KindTrigger, KindResource, KindConnection:
+			if change.Type == ChangeModified && a.isReconfigurable(change) {
+				continue
+			}
+		}
+		unsupported = append(unsupported, change.String())
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("changes require an app restart: %s", strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+func (a *App) isReconfigurable(change *Change) bool {
+	switch change.Kind {
+	case KindTrigger:
+		if tw := a.getTrigger(change.ID); tw != nil {
+			_, ok := tw.trg.(trigger.ReconfigurableTrigger)
+			return ok
+		}
+	case KindResource:
+		if res := a.resManager.GetResource(change.ID); res != nil {
+			_, ok := res.Object().(resource.ReconfigurableResource)
+			return ok
+		}
+	case KindConnection:
+		if manager := connection.GetManager(change.ID); manager != nil {
+			_, ok := manager.(connection.ReconfigurableConnection)
+			return ok
+		}
+	}
+	return false
+}
+
+func (a *App) applyProperties(cfg *Config) error {
+
+	if !property.IsPropertyReconfigureEnabled() {
+		log.RootLogger().Warnf("Property changes might not be picked up by static mappings, set %s=true to enable dynamic property resolution", property.EnvAppPropertyReconfigure)
+	}
+
+	properties := make(map[string]interface{}, len(cfg.Properties))
+	for _, attr := range cfg.Properties {
+		properties[attr.Name()] = attr.Value()
+	}
+
+	// replace the properties and re-run the property post processors
+	a.propManager = property.NewManager(properties)
+	a.propDefs = cfg.PropertyDefinitions()
+	property.SetDefaultManager(a.propManager)
+
+	for _, option := range a.options {
+		err := option(a)
+		if err != nil {
+			return err
+		}
+	}
+
+	return a.validateProperties()
+}
+
+func selectTriggers(cfg *Config, diff *ConfigDiff) []*trigger.Config {
+	ids := make(map[string]bool)
+	for _, change := range diff.Get(KindTrigger) {
+		ids[change.ID] = true
+	}
+	for _, change := range diff.Get(KindHandler) {
+		ids[triggerIdFromHandler(change.ID)] = true
+	}
+
+	var selected []*trigger.Config
+	for _, tConfig := range cfg.Triggers {
+		if ids[tConfig.Id] {
+			selected = append(selected, tConfig)
+		}
+	}
+	return selected
+}
+
+func selectResources(cfg *Config, diff *ConfigDiff) []*resource.Config {
+	ids := make(map[string]bool)
+	for _, change := range diff.Get(KindResource) {
+		ids[change.ID] = true
+	}
+
+	var selected []*resource.Config
+	for _, resConfig := range cfg.Resources {
+		if ids[resConfig.ID] {
+			selected = append(selected, resConfig)
+		}
+	}
+	return selected
+}
+
+func selectConnections(cfg *Config, diff *ConfigDiff) map[string]*connection.Config {
+	selected := make(map[string]*connection.Config)
+	for _, change := range diff.Get(KindConnection) {
+		if connConfig, ok := cfg.Connections[change.ID]; ok {
+			selected[change.ID] = connConfig
+		}
+	}
+	return selected
+}
+
+func handlersByName(tConfig *trigger.Config) map[string]interface{} {
+	handlers := make(map[string]interface{}, len(tConfig.Handlers))
+	for i, hConfig := range tConfig.Handlers {
+		name := hConfig.Name
+		if name == "" {
+			name = tConfig.Id + "_handler" + strconv.Itoa(i+1)
+		}
+		handlers[tConfig.Id+"/"+name] = hConfig
+	}
+	return handlers
+}
+
+func triggerIdFromHandler(handlerId string) string {
+	if idx := strings.Index(handlerId, "/"); idx > 0 {
+		return handlerId[:idx]
+	}
+	return handlerId
+}
+
+func diffMaps(diff *ConfigDiff, kind string, oldValues, newValues map[string]interface{}) {
+	keys := make(map[string]bool, len(oldValues)+len(newValues))
+	for key := range oldValues {
+		keys[key] = true
+	}
+	for key := range newValues {
+		keys[key] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		oldVal, inOld := oldValues[key]
+		newVal, inNew := newValues[key]
+		switch {
+		case !inNew:
+			diff.add(kind, key, ChangeRemoved)
+		case !inOld:
+			diff.add(kind, key, ChangeAdded)
+		case !equalJSON(oldVal, newVal):
+			diff.add(kind, key, ChangeModified)
+		}
+	}
+}
+
+func sortedKeys(oldTriggers, newTriggers map[string]*trigger.Config) []string {
+	keys := make(map[string]bool, len(oldTriggers)+len(newTriggers))
+	for key := range oldTriggers {
+		keys[key] = true
+	}
+	for key := range newTriggers {
+		keys[key] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func toSet(values []string) map[string]interface{} {
+	set := make(map[string]interface{}, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func equalJSON(a, b interface{}) bool {
+	aJson, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJson, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJson, bJson)
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/project-flogo/core/data/property"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadTestConfig(t *testing.T, modify func(cfg map[string]interface{})) *Config {
+	var raw map[string]interface{}
+	err := json.Unmarshal([]byte(app), &raw)
+	assert.Nil(t, err)
+
+	raw["properties"] = []interface{}{
+		map[string]interface{}{"name": "prop1", "type": "string", "value": "a"},
+		map[string]interface{}{"name": "prop2", "type": "int", "value": 1},
+	}
+	if modify != nil {
+		modify(raw)
+	}
+
+	cfgJson, err := json.Marshal(raw)
+	assert.Nil(t, err)
+
+	cfg := &Config{}
+	err = json.Unmarshal(cfgJson, cfg)
+	assert.Nil(t, err)
+	return cfg
+}
+
+func TestDiffConfig(t *testing.T) {
+	oldCfg := loadTestConfig(t, nil)
+
+	diff := DiffConfig(oldCfg, loadTestConfig(t, nil))
+	assert.True(t, diff.IsEmpty())
+
+	newCfg := loadTestConfig(t, func(cfg map[string]interface{}) {
+		cfg["properties"] = []interface{}{
+			map[string]interface{}{"name": "prop1", "type": "string", "value": "b"},
+			map[string]interface{}{"name": "prop3", "type": "string", "value": "c"},
+		}
+		trg := cfg["triggers"].([]interface{})[0].(map[string]interface{})
+		handler := trg["handlers"].([]interface{})[0].(map[string]interface{})
+		handler["settings"] = map[string]interface{}{"aSetting": 3}
+	})
+
+	diff = DiffConfig(oldCfg, newCfg)
+	assert.Equal(t, []*Change{
+		{Kind: KindProperty, ID: "prop1", Type: ChangeModified},
+		{Kind: KindProperty, ID: "prop2", Type: ChangeRemoved},
+		{Kind: KindProperty, ID: "prop3", Type: ChangeAdded},
+		{Kind: KindHandler, ID: "my_trigger/my_trigger_handler1", Type: ChangeModified},
+	}, diff.Changes)
+}
+
+func TestApplyConfig(t *testing.T) {
+	flogoApp, err := New(loadTestConfig(t, nil), nil, ContinueOnError)
+	assert.Nil(t, err)
+
+	_, err = flogoApp.ApplyConfig(loadTestConfig(t, nil))
+	assert.NotNil(t, err, "app not started")
+
+	err = flogoApp.Start()
+	assert.Nil(t, err)
+	defer flogoApp.Stop()
+
+	diff, err := flogoApp.ApplyConfig(loadTestConfig(t, func(cfg map[string]interface{}) {
+		cfg["properties"] = []interface{}{
+			map[string]interface{}{"name": "prop1", "type": "string", "value": "b"},
+			map[string]interface{}{"name": "prop2", "type": "int", "value": 1},
+		}
+	}))
+	assert.Nil(t, err)
+	assert.Len(t, diff.Changes, 1)
+
+	val, _ := property.DefaultManager().GetProperty("prop1")
+	assert.Equal(t, "b", val)
+
+	// the example trigger isn't reconfigurable
+	_, err = flogoApp.ApplyConfig(loadTestConfig(t, func(cfg map[string]interface{}) {
+		trg := cfg["triggers"].([]interface{})[0].(map[string]interface{})
+		trg["settings"] = map[string]interface{}{"aSetting": 3}
+	}))
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "trigger [my_trigger] modified"))
+
+	// properties weren't changed by the failed reload
+	val, _ = property.DefaultManager().GetProperty("prop1")
+	assert.Equal(t, "b", val)
+}
+
+// run with -race to check the app can be read while it is being reconfigured
+func TestApplyConfigConcurrent(t *testing.T) {
+	flogoApp, err := New(loadTestConfig(t, nil), nil, ContinueOnError)
+	assert.Nil(t, err)
+
+	err = flogoApp.Start()
+	assert.Nil(t, err)
+	defer flogoApp.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			_, err := flogoApp.ApplyConfig(loadTestConfig(t, func(cfg map[string]interface{}) {
+				cfg["properties"] = []interface{}{
+					map[string]interface{}{"name": "prop1", "type": "string", "value": strconv.Itoa(i)},
+				}
+			}))
+			assert.Nil(t, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			val, _ := flogoApp.GetProperty("prop1")
+			assert.Equal(t, "19", val)
+			return
+		default:
+			_, _ = flogoApp.GetProperty("prop1")
+			_ = flogoApp.TriggerStatuses()
+		}
+	}
+}
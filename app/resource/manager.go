@@ -54,6 +54,16 @@ func (m *Manager) GetResource(id string) *Resource {
 	return m.resources[resId]
 }
 
+// Resources returns all the resources managed by the manager
+func (m *Manager) Resources() map[string]*Resource {
+	ret := make(map[string]*Resource, len(m.resources))
+	for id, res := range m.resources {
+		ret[id] = res
+	}
+
+	return ret
+}
+
 func (m *Manager) SetResource(id string, res *Resource) {
 	resId := id
 	if strings.HasPrefix(id, UriScheme) {
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/project-flogo/core/engine/secret"
+)
+
+const usage = `Encrypts and decrypts secret values using the configured secret key (FLOGO_DATA_SECRET_KEY,
+FLOGO_DATA_SECRET_KEYRING or FLOGO_DATA_SECRET_STORE_URL)
+
+Usage:
+  flogo-secret encrypt [<value> | -]
+  flogo-secret decrypt [<value> | -]
+  flogo-secret encrypt -props <names> (-app <flogo.json> | -file <props.json>) [-o <output>]
+  flogo-secret decrypt [-props <names>] (-app <flogo.json> | -file <props.json>) (-o <output> | -in-place)
+  flogo-secret rotate (-old-key <key> | -old-keyring <keyring.json>) (-app <flogo.json> | -file <props.json>) [-o <output>]
+
+The value is read from stdin when it is '-' or omitted, so that it isn't kept in the shell history.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage(nil)
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet("flogo-secret "+command, flag.ExitOnError)
+	props := flags.String("props", "", "Comma separated names of the properties to encrypt or decrypt")
+	appFile := flags.String("app", "", "The app config (flogo.json) whose properties are rewritten")
+	propFile := flags.String("file", "", "The external property file (json) whose properties are rewritten")
+	output := flags.String("o", "", "The file the rewritten app config or property file is written to, defaults to the input file when encrypting or rotating")
+	inPlace := flags.Bool("in-place", false, "Overwrite the input file with the decrypted values when decrypting without -o")
+	oldKey := flags.String("old-key", "", "The key the values are currently encrypted with, when rotating")
+	oldKeyring := flags.String("old-keyring", "", "The keyring the values are currently encrypted with, when rotating")
+	flags.Usage = func() { printUsage(flags) }
+	_ = flags.Parse(os.Args[2:])
+
+	handler, err := secret.NewSecretValueHandler()
+	if err != nil {
+		exitOnError(err)
+	}
+
+	var names []string
+	if *props != "" {
+		for _, name := range strings.Split(*props, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+
+	var rewriter secret.PropertyRewriter
+	switch command {
+	case "encrypt", "decrypt":
+		if *appFile == "" && *propFile == "" {
+			if flags.NArg() > 1 {
+				printUsage(flags)
+				os.Exit(2)
+			}
+			value, err := readValue(flags.Arg(0), os.Stdin)
+			if err != nil {
+				exitOnError(err)
+			}
+			secret.SetSecretValueHandler(handler)
+			if command == "encrypt" {
+				value, err = secret.EncryptValue(value)
+			} else {
+				value, err = secret.DecryptValue(value)
+			}
+			if err != nil {
+				exitOnError(err)
+			}
+			fmt.Println(value)
+			return
+		}
+		if command == "encrypt" {
+			if len(names) == 0 {
+				exitOnError(fmt.Errorf("the properties to encrypt must be specified using -props"))
+			}
+			rewriter = secret.Encrypter(handler, names...)
+		} else {
+			rewriter = secret.Decrypter(handler, names...)
+		}
+	case "rotate":
+		var oldHandler secret.SecretValueHandler
+		switch {
+		case *oldKey != "":
+			oldHandler = &secret.KeyBasedSecretValueHandler{Key: *oldKey}
+		case *oldKeyring != "":
+			keyring, err := secret.LoadKeyring(*oldKeyring)
+			if err != nil {
+				exitOnError(err)
+			}
+			oldHandler = &secret.ProviderSecretValueHandler{Provider: keyring}
+		default:
+			exitOnError(fmt.Errorf("the old key must be specified using -old-key or -old-keyring"))
+		}
+		rewriter = secret.Rotator(oldHandler, handler)
+	default:
+		printUsage(flags)
+		os.Exit(2)
+	}
+
+	// decrypted values are only written over the input file when explicitly asked to
+	overwrite := command != "decrypt" || *inPlace
+	if err := rewriteFile(*appFile, *propFile, *output, overwrite, rewriter); err != nil {
+		exitOnError(err)
+	}
+}
+
+// readValue returns the value given as argument, or reads it from stdin when it is '-' or empty
+func readValue(arg string, stdin io.Reader) (string, error) {
+	if arg != "" && arg != "-" {
+		return arg, nil
+	}
+
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("no value read from stdin")
+	}
+	return value, nil
+}
+
+// rewriteFile rewrites the properties of the app config or property file, the result is written to output or, when
+// overwrite is set, to the input file if no output is specified
+func rewriteFile(appFile, propFile, output string, overwrite bool, rewriter secret.PropertyRewriter) error {
+	if appFile != "" && propFile != "" {
+		return fmt.Errorf("only one of -app and -file can be specified")
+	}
+	if appFile == "" && propFile == "" {
+		return fmt.Errorf("the file to rewrite must be specified using -app or -file")
+	}
+
+	file := appFile
+	if file == "" {
+		file = propFile
+	}
+	if output == "" && !overwrite {
+		return fmt.Errorf("the decrypted values are only written over %s when -in-place is specified, use -o to write them to another file", file)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if appFile != "" {
+		data, names, err = secret.RewriteAppProperties(data, rewriter)
+	} else {
+		data, names, err = secret.RewritePropertyFile(data, rewriter)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		output = file
+	}
+	if len(names) == 0 && output == file {
+		fmt.Printf("No properties rewritten in %s\n", file)
+		return nil
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Rewrote %d properties in %s: %s\n", len(names), output, strings.Join(names, ", "))
+	return nil
+}
+
+func printUsage(flags *flag.FlagSet) {
+	fmt.Fprint(os.Stderr, usage)
+	if flags != nil {
+		fmt.Fprint(os.Stderr, "\nFlags:\n")
+		flags.PrintDefaults()
+	}
+}
+
+func exitOnError(err error) {
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-flogo/core/engine/secret"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadValue(t *testing.T) {
+	value, err := readValue("mysecurepassword", strings.NewReader("ignored"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword", value)
+
+	value, err = readValue("-", strings.NewReader("mysecurepassword\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword", value)
+
+	value, err = readValue("", strings.NewReader("mysecurepassword\r\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword", value)
+
+	_, err = readValue("", strings.NewReader("\n"))
+	assert.NotNil(t, err)
+}
+
+func TestRewriteFileDecrypt(t *testing.T) {
+	handler := &secret.KeyBasedSecretValueHandler{Key: "mysecretkey"}
+
+	dir := t.TempDir()
+	propFile := filepath.Join(dir, "props.json")
+	err := os.WriteFile(propFile, []byte(`{"db.password": "mysecurepassword"}`), 0644)
+	assert.Nil(t, err)
+
+	err = rewriteFile("", propFile, "", true, secret.Encrypter(handler, "db.password"))
+	assert.Nil(t, err)
+	encrypted, err := os.ReadFile(propFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(encrypted), secret.SecretPrefix)
+
+	// decrypted values are not written over the input file unless asked to
+	err = rewriteFile("", propFile, "", false, secret.Decrypter(handler))
+	assert.NotNil(t, err)
+	data, err := os.ReadFile(propFile)
+	assert.Nil(t, err)
+	assert.Equal(t, encrypted, data)
+
+	output := filepath.Join(dir, "props.dev.json")
+	err = rewriteFile("", propFile, output, false, secret.Decrypter(handler))
+	assert.Nil(t, err)
+	data, err = os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "mysecurepassword")
+
+	err = rewriteFile("", propFile, "", true, secret.Decrypter(handler))
+	assert.Nil(t, err)
+	data, err = os.ReadFile(propFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "mysecurepassword")
+}
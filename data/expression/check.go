@@ -0,0 +1,224 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/project-flogo/core/data/schema"
+)
+
+// CheckEnv describes the values available to an expression when it is statically checked
+type CheckEnv struct {
+	// Scope is the metadata of the values available in the scope ($.name), if nil scope references are not checked
+	Scope map[string]data.TypedValue
+}
+
+// Checker is implemented by expressions that can be statically checked
+type Checker interface {
+	// Check infers the type of the expression and reports any problems found
+	Check(env *CheckEnv) (data.Type, []error)
+}
+
+// Check infers the type of the expression and reports any problems found, expressions that cannot be
+// checked are of type any
+func Check(expr Expr, env *CheckEnv) (data.Type, []error) {
+	if env == nil {
+		env = &CheckEnv{}
+	}
+	if c, ok := expr.(Checker); ok {
+		return c.Check(env)
+	}
+	return data.TypeAny, nil
+}
+
+// Diagnostic is a problem found while statically checking an expression or mapping
+type Diagnostic struct {
+	// Location identifies where the problem was found, ex. trigger[rest].handler[get].actions[0].input.id
+	Location string
+	Err      error
+}
+
+func (d *Diagnostic) Error() string {
+	if d.Location == "" {
+		return d.Err.Error()
+	}
+	return d.Location + ": " + d.Err.Error()
+}
+
+// IsKnownType returns whether the type is a concrete type, as opposed to any or unknown
+func IsKnownType(t data.Type) bool {
+	return t != data.TypeAny && t != data.TypeUnknown
+}
+
+// IsNumericType returns whether the type is an integer or floating point type
+func IsNumericType(t data.Type) bool {
+	switch t {
+	case data.TypeInt, data.TypeInt32, data.TypeInt64, data.TypeFloat32, data.TypeFloat64:
+		return true
+	}
+	return false
+}
+
+// CanCoerce returns whether a value of type 'from' can possibly be coerced to type 'to'; it only returns
+// false if the coercion is certain to fail, ex. an object to an int
+func CanCoerce(from, to data.Type) bool {
+	if !IsKnownType(from) || !IsKnownType(to) || from == to {
+		return true
+	}
+
+	switch to {
+	case data.TypeInt, data.TypeInt32, data.TypeInt64, data.TypeFloat32, data.TypeFloat64:
+		return from == data.TypeString || from == data.TypeBool || IsNumericType(from)
+	case data.TypeBool:
+		return from == data.TypeString || IsNumericType(from)
+	case data.TypeObject, data.TypeParams:
+		return from == data.TypeString || from == data.TypeBytes || from == data.TypeObject || from == data.TypeParams || from == data.TypeMap
+	case data.TypeDateTime:
+		return from == data.TypeString || IsNumericType(from)
+	}
+
+	// strings, bytes and arrays can be created from any value, maps and connections are not coerced
+	return true
+}
+
+// CheckRef checks a resolver reference, ex. $.name.field, against the environment and infers its type. Only
+// scope references are checked, other references are of type any.  A reference to a value that does not exist
+// is reported with a resolve.NotFoundError
+func CheckRef(ref string, env *CheckEnv) (data.Type, error) {
+	if env == nil || env.Scope == nil || len(ref) < 2 || ref[0] != '$' {
+		return data.TypeAny, nil
+	}
+
+	directive := ref[1:]
+	resolverName, nextIdx := resolve.GetResolverInfo(directive)
+	if resolverName != "." {
+		return data.TypeAny, nil
+	}
+
+	details, err := resolve.GetResolveDirectiveDetails(directive[nextIdx:], false, false)
+	if err != nil || details.ValueName == "" {
+		return data.TypeAny, nil
+	}
+
+	value, exists := env.Scope[details.ValueName]
+	if !exists {
+		return data.TypeAny, resolve.NewNotFoundError("unknown reference '%s'", ref)
+	}
+
+	if details.Path == "" {
+		return value.Type(), nil
+	}
+
+	switch t := value.Type(); {
+	case IsNumericType(t), t == data.TypeBool, t == data.TypeDateTime:
+		return data.TypeAny, fmt.Errorf("cannot access '%s' of '$.%s', it is of type %s", details.Path, details.ValueName, t)
+	}
+
+	if hs, ok := value.(schema.HasSchema); ok && hs.Schema() != nil {
+		return checkSchemaPath(ref, hs.Schema(), details.Path)
+	}
+
+	return data.TypeAny, nil
+}
+
+// checkSchemaPath walks a json schema following the path, properties not declared by an object are
+// reported unless the object allows additional properties
+func checkSchemaPath(ref string, s schema.Schema, path string) (data.Type, error) {
+	if s.Type() != "json" || s.Value() == "" {
+		return data.TypeAny, nil
+	}
+
+	var node map[string]interface{}
+	if err := json.Unmarshal([]byte(s.Value()), &node); err != nil {
+		return data.TypeAny, nil
+	}
+
+	for _, seg := range splitPath(path) {
+		typ, _ := node["type"].(string)
+		switch {
+		case seg.isIndex && typ == "array":
+			items, ok := node["items"].(map[string]interface{})
+			if !ok {
+				return data.TypeAny, nil
+			}
+			node = items
+		case !seg.isIndex && typ == "object":
+			props, ok := node["properties"].(map[string]interface{})
+			if !ok {
+				return data.TypeAny, nil
+			}
+			prop, ok := props[seg.name].(map[string]interface{})
+			if !ok {
+				// additional properties are allowed unless the schema explicitly disallows them
+				if additional, isBool := node["additionalProperties"].(bool); !isBool || additional || props[seg.name] != nil {
+					return data.TypeAny, nil
+				}
+				return data.TypeAny, resolve.NewNotFoundError("unknown reference '%s', '%s' is not defined in the schema", ref, seg.name)
+			}
+			node = prop
+		default:
+			return data.TypeAny, nil
+		}
+	}
+
+	typ, _ := node["type"].(string)
+	return schemaType(typ), nil
+}
+
+func schemaType(typ string) data.Type {
+	switch typ {
+	case "string":
+		return data.TypeString
+	case "integer":
+		return data.TypeInt
+	case "number":
+		return data.TypeFloat64
+	case "boolean":
+		return data.TypeBool
+	case "object":
+		return data.TypeObject
+	case "array":
+		return data.TypeArray
+	}
+	return data.TypeAny
+}
+
+type pathSegment struct {
+	name    string
+	isIndex bool
+}
+
+// splitPath splits a path, ex. .foo["bar"][0], into its segments
+func splitPath(path string) []pathSegment {
+	var segments []pathSegment
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			end := strings.IndexAny(path[1:], ".[")
+			if end < 0 {
+				end = len(path) - 1
+			}
+			segments = append(segments, pathSegment{name: path[1 : end+1]})
+			path = path[end+1:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return segments
+			}
+			key := path[1:end]
+			if _, err := strconv.Atoi(key); err == nil {
+				segments = append(segments, pathSegment{isIndex: true})
+			} else {
+				segments = append(segments, pathSegment{name: strings.Trim(key, "\"'`")})
+			}
+			path = path[end+1:]
+		default:
+			return segments
+		}
+	}
+	return segments
+}
@@ -0,0 +1,112 @@
+package expression
+
+import (
+	"testing"
+	"time"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanCoerce(t *testing.T) {
+	samples := map[data.Type]interface{}{
+		data.TypeString:   "abc",
+		data.TypeInt:      1,
+		data.TypeInt64:    int64(1),
+		data.TypeFloat64:  1.5,
+		data.TypeBool:     true,
+		data.TypeObject:   map[string]interface{}{"a": 1},
+		data.TypeParams:   map[string]string{"a": "b"},
+		data.TypeArray:    []interface{}{1},
+		data.TypeBytes:    []byte("abc"),
+		data.TypeDateTime: time.Now(),
+	}
+
+	// CanCoerce must never report a coercion that succeeds as impossible
+	for from, val := range samples {
+		for to := range samples {
+			if _, err := coerce.ToType(val, to); err == nil {
+				assert.True(t, CanCoerce(from, to), "%s to %s", from, to)
+			}
+		}
+	}
+
+	assert.False(t, CanCoerce(data.TypeObject, data.TypeInt))
+	assert.False(t, CanCoerce(data.TypeArray, data.TypeObject))
+	assert.False(t, CanCoerce(data.TypeBool, data.TypeDateTime))
+	assert.False(t, CanCoerce(data.TypeDateTime, data.TypeBool))
+	assert.True(t, CanCoerce(data.TypeAny, data.TypeInt))
+	assert.True(t, CanCoerce(data.TypeObject, data.TypeUnknown))
+}
+
+type testSchema struct {
+	value string
+}
+
+func (s *testSchema) Type() string {
+	return "json"
+}
+
+func (s *testSchema) Value() string {
+	return s.value
+}
+
+func (s *testSchema) Validate(data interface{}) error {
+	return nil
+}
+
+func TestCheckRef(t *testing.T) {
+	customer := `{"type":"object","additionalProperties":false,"properties":{"name":{"type":"string"},"age":{"type":"integer"},
+		"orders":{"type":"array","items":{"type":"object","properties":{"total":{"type":"number"}}}}}}`
+	order := `{"type":"object","properties":{"total":{"type":"number"}}}`
+
+	env := &CheckEnv{Scope: map[string]data.TypedValue{
+		"id":       data.NewAttribute("id", data.TypeInt, nil),
+		"headers":  data.NewAttribute("headers", data.TypeParams, nil),
+		"customer": data.NewAttributeWithSchema("customer", data.TypeObject, nil, &testSchema{value: customer}),
+		"order":    data.NewAttributeWithSchema("order", data.TypeObject, nil, &testSchema{value: order}),
+	}}
+
+	typ, err := CheckRef("$.id", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeInt, typ)
+
+	_, err = CheckRef("$.nope", env)
+	assert.EqualError(t, err, "unknown reference '$.nope'")
+
+	_, err = CheckRef("$.id.value", env)
+	assert.NotNil(t, err)
+
+	typ, err = CheckRef("$.headers.Accept", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeAny, typ)
+
+	typ, err = CheckRef("$.customer.name", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeString, typ)
+
+	typ, err = CheckRef(`$.customer.orders[0]["total"]`, env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeFloat64, typ)
+
+	_, err = CheckRef("$.customer.nmae", env)
+	assert.NotNil(t, err)
+
+	// additional properties are allowed when the schema doesn't disallow them
+	typ, err = CheckRef("$.order.discount", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeAny, typ)
+
+	typ, err = CheckRef("$.customer.orders[0].discount", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeAny, typ)
+
+	// only scope references are checked
+	typ, err = CheckRef("$env[HOME]", env)
+	assert.Nil(t, err)
+	assert.Equal(t, data.TypeAny, typ)
+
+	_, err = CheckRef("$.nope", &CheckEnv{})
+	assert.Nil(t, err)
+}
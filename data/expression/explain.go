@@ -0,0 +1,108 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+)
+
+// Explanation is the result of evaluating an expression along with the results of its sub-expressions, its
+// fields mirror those of the evaluation details used for test assertions
+type Explanation struct {
+	Type       string         `json:"expressionType"`
+	Name       string         `json:"expressionName,omitempty"`
+	Evaluation string         `json:"expressionEvaluation,omitempty"`
+	Value      interface{}    `json:"value"`
+	Error      string         `json:"error,omitempty"`
+	Children   []*Explanation `json:"children,omitempty"`
+}
+
+// Explainer is implemented by expressions that can explain their evaluation
+type Explainer interface {
+	// Explain evaluates the expression, returning an explanation of how the result was arrived at
+	Explain(scope data.Scope) (interface{}, *Explanation, error)
+}
+
+// Explain evaluates the expression and explains how the result was arrived at, expressions that cannot
+// explain themselves are explained by their result
+func Explain(expr Expr, scope data.Scope) (interface{}, *Explanation, error) {
+	if e, ok := expr.(Explainer); ok {
+		return e.Explain(scope)
+	}
+
+	val, err := expr.Eval(scope)
+	return val, NewExplanation("expression", "", val, err), err
+}
+
+// NewExplanation creates an explanation of an evaluation without sub-expressions
+func NewExplanation(exprType, name string, val interface{}, err error) *Explanation {
+	e := &Explanation{Type: exprType, Name: name}
+	e.SetResult(val, err)
+	return e
+}
+
+// SetResult sets the result of the evaluation being explained
+func (e *Explanation) SetResult(val interface{}, err error) {
+	e.Value = val
+	if err != nil {
+		e.Error = err.Error()
+	}
+}
+
+// String formats the explanation as an indented tree, one sub-expression per line
+func (e *Explanation) String() string {
+	var b strings.Builder
+	e.write(&b, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (e *Explanation) write(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(e.Type)
+	if e.Name != "" {
+		b.WriteString(" ")
+		b.WriteString(e.Name)
+	}
+	if e.Evaluation != "" {
+		b.WriteString(" [")
+		b.WriteString(e.Evaluation)
+		b.WriteString("]")
+	}
+	if e.Error != "" {
+		b.WriteString(" => error: ")
+		b.WriteString(e.Error)
+	} else {
+		b.WriteString(" => ")
+		b.WriteString(formatValue(e.Value))
+	}
+	b.WriteString("\n")
+
+	for _, child := range e.Children {
+		child.write(b, depth+1)
+	}
+}
+
+func formatValue(val interface{}) string {
+	switch t := val.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", t)
+	}
+	s, err := coerce.ToString(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+	return s
+}
+
+func (e *literalExpr) Explain(scope data.Scope) (interface{}, *Explanation, error) {
+	return e.val, NewExplanation("literal", "", e.val, nil), nil
+}
+
+func (e *resolutionExpr) Explain(scope data.Scope) (interface{}, *Explanation, error) {
+	val, err := e.Eval(scope)
+	return val, NewExplanation("reference", e.ref, val, err), err
+}
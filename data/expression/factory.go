@@ -41,7 +41,7 @@ func (f *factoryImpl) NewExpr(exprStr string) (Expr, error) {
 			val, _ := resolution.GetValue(nil)
 			return &literalExpr{val: val}, nil
 		} else {
-			return &resolutionExpr{resolution: resolution}, nil
+			return &resolutionExpr{ref: exprStr, resolution: resolution}, nil
 		}
 	}
 
@@ -0,0 +1,441 @@
+package array
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/project-flogo/core/data/path"
+)
+
+func init() {
+	_ = function.Register(&fnContains{})
+	_ = function.Register(&fnIndexOf{})
+	_ = function.Register(&fnFilter{})
+	_ = function.Register(&fnSort{})
+	_ = function.Register(&fnSortBy{})
+	_ = function.Register(&fnDistinct{})
+	_ = function.Register(&fnReverse{})
+	_ = function.Register(&fnMerge{})
+	_ = function.Register(&fnSlice{})
+	_ = function.Register(&fnGet{})
+	_ = function.Register(&fnMap{})
+	_ = function.Register(&fnFind{})
+	_ = function.Register(&fnSome{})
+	_ = function.Register(&fnEvery{})
+	_ = function.Register(&fnReduce{})
+}
+
+// fnContains checks if the array contains the value
+type fnContains struct {
+}
+
+func (*fnContains) Name() string {
+	return "contains"
+}
+
+func (*fnContains) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnContains) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+	return indexOf(arr, params[1]) >= 0, nil
+}
+
+// fnIndexOf returns the index of the value in the array, or -1
+type fnIndexOf struct {
+}
+
+func (*fnIndexOf) Name() string {
+	return "indexOf"
+}
+
+func (*fnIndexOf) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnIndexOf) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+	return indexOf(arr, params[1]), nil
+}
+
+func indexOf(arr []interface{}, val interface{}) int {
+	for i, v := range arr {
+		if equal(v, val) {
+			return i
+		}
+	}
+	return -1
+}
+
+// fnFilter returns the elements of the array for which the lambda is true, e.g. array.filter($.items, x => x.price > 10),
+// the elements equal to the value, or, if a path is specified, the elements whose value at the path is equal to
+// the value, e.g. array.filter($.items, "status", "open")
+type fnFilter struct {
+}
+
+func (*fnFilter) Name() string {
+	return "filter"
+}
+
+func (*fnFilter) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnFilter) Eval(params ...interface{}) (interface{}, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return nil, fmt.Errorf("filter function should have 2 or 3 arguments")
+	}
+
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if l, ok := params[1].(function.Lambda); ok && len(params) == 2 {
+		result := make([]interface{}, 0, len(arr))
+		for i, elem := range arr {
+			match, err := callPredicate(l, elem, i)
+			if err != nil {
+				return nil, err
+			}
+			if match {
+				result = append(result, elem)
+			}
+		}
+		return result, nil
+	}
+
+	var elemPath string
+	val := params[len(params)-1]
+	if len(params) == 3 {
+		elemPath, err = coerce.ToString(params[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]interface{}, 0, len(arr))
+	for _, elem := range arr {
+		if equal(valueAt(elem, elemPath), val) {
+			result = append(result, elem)
+		}
+	}
+	return result, nil
+}
+
+// fnSort returns a copy of the array sorted in ascending order, or descending order if the
+// optional second argument is true
+type fnSort struct {
+}
+
+func (*fnSort) Name() string {
+	return "sort"
+}
+
+func (*fnSort) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnSort) Eval(params ...interface{}) (interface{}, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return nil, fmt.Errorf("sort function should have 1 or 2 arguments")
+	}
+
+	descending := false
+	if len(params) == 2 {
+		var err error
+		descending, err = coerce.ToBool(params[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sortArray(params[0], "", descending)
+}
+
+// fnSortBy returns a copy of the array of objects sorted by the value at the path, or the value returned by
+// the lambda, e.g. array.sortBy($.items, x => x.price), in ascending order, or descending order if the optional
+// third argument is true
+type fnSortBy struct {
+}
+
+func (*fnSortBy) Name() string {
+	return "sortBy"
+}
+
+func (*fnSortBy) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnSortBy) Eval(params ...interface{}) (interface{}, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return nil, fmt.Errorf("sortBy function should have 2 or 3 arguments")
+	}
+
+	var key interface{}
+	if l, ok := params[1].(function.Lambda); ok {
+		key = l
+	} else {
+		elemPath, err := coerce.ToString(params[1])
+		if err != nil {
+			return nil, err
+		}
+		key = elemPath
+	}
+
+	descending := false
+	if len(params) == 3 {
+		var err error
+		descending, err = coerce.ToBool(params[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sortArray(params[0], key, descending)
+}
+
+// sortArray sorts the array by the value at the path or the value returned by the lambda
+func sortArray(val interface{}, key interface{}, descending bool) ([]interface{}, error) {
+	arr, err := coerce.ToArray(val)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]interface{}, len(arr))
+	for i, elem := range arr {
+		switch t := key.(type) {
+		case function.Lambda:
+			keys[i], err = t.Call(elem, i)
+			if err != nil {
+				return nil, err
+			}
+		case string:
+			keys[i] = valueAt(elem, t)
+		}
+	}
+
+	idx := make([]int, len(arr))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		c := compare(keys[idx[i]], keys[idx[j]])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	sorted := make([]interface{}, len(arr))
+	for i, from := range idx {
+		sorted[i] = arr[from]
+	}
+	return sorted, nil
+}
+
+// fnDistinct returns the distinct elements of the array, in the order they first occur
+type fnDistinct struct {
+}
+
+func (*fnDistinct) Name() string {
+	return "distinct"
+}
+
+func (*fnDistinct) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray}, false
+}
+
+func (*fnDistinct) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(arr))
+	for _, v := range arr {
+		if indexOf(result, v) < 0 {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// fnReverse returns a copy of the array in reverse order
+type fnReverse struct {
+}
+
+func (*fnReverse) Name() string {
+	return "reverse"
+}
+
+func (*fnReverse) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray}, false
+}
+
+func (*fnReverse) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, len(arr))
+	for i, v := range arr {
+		result[len(arr)-1-i] = v
+	}
+	return result, nil
+}
+
+// fnMerge concatenates the arrays
+type fnMerge struct {
+}
+
+func (*fnMerge) Name() string {
+	return "merge"
+}
+
+func (*fnMerge) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray}, true
+}
+
+func (*fnMerge) Eval(params ...interface{}) (interface{}, error) {
+	result := make([]interface{}, 0)
+	for _, p := range params {
+		arr, err := coerce.ToArray(p)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, arr...)
+	}
+	return result, nil
+}
+
+// fnSlice returns the elements of the array from the start index up to, but excluding, the end index
+type fnSlice struct {
+}
+
+func (*fnSlice) Name() string {
+	return "slice"
+}
+
+func (*fnSlice) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeInt, data.TypeInt}, false
+}
+
+func (*fnSlice) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := params[1].(int), params[2].(int)
+	if end > len(arr) {
+		end = len(arr)
+	}
+	if start < 0 || start > end {
+		return nil, fmt.Errorf("invalid slice indices [%d:%d] for array of length %d", start, end, len(arr))
+	}
+
+	result := make([]interface{}, end-start)
+	copy(result, arr[start:end])
+	return result, nil
+}
+
+// fnGet returns the element of the array at the index, or nil if the index is out of range
+type fnGet struct {
+}
+
+func (*fnGet) Name() string {
+	return "get"
+}
+
+func (*fnGet) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeInt}, false
+}
+
+func (*fnGet) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	idx := params[1].(int)
+	if idx < 0 || idx >= len(arr) {
+		return nil, nil
+	}
+	return arr[idx], nil
+}
+
+// valueAt returns the value at the path of the element, the element itself if no path is specified,
+// the value of a path that does not exist in the element is nil
+func valueAt(elem interface{}, elemPath string) interface{} {
+	if elemPath == "" {
+		return elem
+	}
+	if !strings.HasPrefix(elemPath, ".") && !strings.HasPrefix(elemPath, "[") {
+		elemPath = "." + elemPath
+	}
+	v, err := path.GetValue(elem, elemPath)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// equal compares two values, numbers are compared by value regardless of their type
+func equal(a, b interface{}) bool {
+	if isNumber(a) && isNumber(b) {
+		fa, _ := coerce.ToFloat64(a)
+		fb, _ := coerce.ToFloat64(b)
+		return fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compare orders numbers by value and everything else by its string representation, nil comes first
+func compare(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if isNumber(a) && isNumber(b) {
+		fa, _ := coerce.ToFloat64(a)
+		fb, _ := coerce.ToFloat64(b)
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+
+	sa, _ := coerce.ToString(a)
+	sb, _ := coerce.ToString(b)
+	return strings.Compare(sa, sb)
+}
+
+func isNumber(val interface{}) bool {
+	switch val.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+var items = []interface{}{
+	map[string]interface{}{"name": "b", "price": 20.0, "status": "open"},
+	map[string]interface{}{"name": "a", "price": 5, "status": "closed"},
+	map[string]interface{}{"name": "c", "price": 12.5, "status": "open"},
+}
+
+func TestFnContains_Eval(t *testing.T) {
+	v, err := function.Eval(&fnContains{}, []interface{}{1.0, 2.0}, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = function.Eval(&fnContains{}, []string{"a", "b"}, "c")
+	assert.Nil(t, err)
+	assert.Equal(t, false, v)
+
+	v, err = function.Eval(&fnIndexOf{}, `["a","b"]`, "b")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func TestFnFilter_Eval(t *testing.T) {
+	v, err := function.Eval(&fnFilter{}, items, "status", "open")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{items[0], items[2]}, v)
+
+	v, err = function.Eval(&fnFilter{}, items, "missing.field", "open")
+	assert.Nil(t, err)
+	assert.Empty(t, v)
+
+	v, err = function.Eval(&fnFilter{}, []interface{}{1, 2, 1}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, 1}, v)
+
+	_, err = function.Eval(&fnFilter{}, items)
+	assert.NotNil(t, err)
+}
+
+func TestFnSort_Eval(t *testing.T) {
+	v, err := function.Eval(&fnSort{}, []interface{}{3, 1.5, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1.5, 2, 3}, v)
+
+	v, err = function.Eval(&fnSort{}, []string{"b", "c", "a"}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"c", "b", "a"}, v)
+
+	v, err = function.Eval(&fnSortBy{}, items, "price")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{items[1], items[2], items[0]}, v)
+
+	v, err = function.Eval(&fnSortBy{}, items, "name", true)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{items[2], items[0], items[1]}, v)
+}
+
+func TestFnDistinct_Eval(t *testing.T) {
+	v, err := function.Eval(&fnDistinct{}, []interface{}{1, 1.0, "1", 2, "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, "1", 2}, v)
+}
+
+func TestFnManipulate_Eval(t *testing.T) {
+	v, err := function.Eval(&fnReverse{}, []int{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{3, 2, 1}, v)
+
+	v, err = function.Eval(&fnMerge{}, []int{1}, []string{"a", "b"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, "a", "b"}, v)
+
+	v, err = function.Eval(&fnSlice{}, []int{1, 2, 3, 4}, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{2, 3, 4}, v)
+
+	_, err = function.Eval(&fnSlice{}, []int{1, 2, 3, 4}, 3, 1)
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnGet{}, []int{1, 2}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v)
+
+	v, err = function.Eval(&fnGet{}, []int{1, 2}, 5)
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+}
@@ -0,0 +1,176 @@
+package array
+
+import (
+	"fmt"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+// The functions in this file take a lambda that is called with each element of the array and its index,
+// e.g. array.map($.items, (x, i) => x.price * i)
+
+func toArrayAndLambda(name string, params []interface{}) ([]interface{}, function.Lambda, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l, ok := params[1].(function.Lambda)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s function requires a lambda as second argument, e.g. x => x.price > 10", name)
+	}
+
+	return arr, l, nil
+}
+
+func callPredicate(l function.Lambda, elem interface{}, idx int) (bool, error) {
+	v, err := l.Call(elem, idx)
+	if err != nil {
+		return false, err
+	}
+	return coerce.ToBool(v)
+}
+
+// fnMap returns the values returned by the lambda for each element of the array
+type fnMap struct {
+}
+
+func (*fnMap) Name() string {
+	return "map"
+}
+
+func (*fnMap) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnMap) Eval(params ...interface{}) (interface{}, error) {
+	arr, l, err := toArrayAndLambda("map", params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, len(arr))
+	for i, elem := range arr {
+		result[i], err = l.Call(elem, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// fnFind returns the first element of the array for which the lambda is true, or nil
+type fnFind struct {
+}
+
+func (*fnFind) Name() string {
+	return "find"
+}
+
+func (*fnFind) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnFind) Eval(params ...interface{}) (interface{}, error) {
+	arr, l, err := toArrayAndLambda("find", params)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, elem := range arr {
+		match, err := callPredicate(l, elem, i)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			return elem, nil
+		}
+	}
+	return nil, nil
+}
+
+// fnSome checks if the lambda is true for at least one element of the array
+type fnSome struct {
+}
+
+func (*fnSome) Name() string {
+	return "some"
+}
+
+func (*fnSome) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnSome) Eval(params ...interface{}) (interface{}, error) {
+	arr, l, err := toArrayAndLambda("some", params)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, elem := range arr {
+		match, err := callPredicate(l, elem, i)
+		if err != nil || match {
+			return match, err
+		}
+	}
+	return false, nil
+}
+
+// fnEvery checks if the lambda is true for all the elements of the array
+type fnEvery struct {
+}
+
+func (*fnEvery) Name() string {
+	return "every"
+}
+
+func (*fnEvery) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny}, false
+}
+
+func (*fnEvery) Eval(params ...interface{}) (interface{}, error) {
+	arr, l, err := toArrayAndLambda("every", params)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, elem := range arr {
+		match, err := callPredicate(l, elem, i)
+		if err != nil || !match {
+			return match, err
+		}
+	}
+	return true, nil
+}
+
+// fnReduce combines the elements of the array using the lambda, which is called with the accumulated
+// value, the element and its index, starting with the initial value,
+// e.g. array.reduce($.items, (total, x) => total + x.price, 0)
+type fnReduce struct {
+}
+
+func (*fnReduce) Name() string {
+	return "reduce"
+}
+
+func (*fnReduce) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeAny, data.TypeAny}, false
+}
+
+func (*fnReduce) Eval(params ...interface{}) (interface{}, error) {
+	arr, l, err := toArrayAndLambda("reduce", params)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := params[2]
+	for i, elem := range arr {
+		acc, err = l.Call(acc, elem, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
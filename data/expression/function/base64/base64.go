@@ -0,0 +1,87 @@
+package base64
+
+import (
+	"encoding/base64"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnEncode{})
+	_ = function.Register(&fnDecode{})
+	_ = function.Register(&fnEncodeURL{})
+	_ = function.Register(&fnDecodeURL{})
+}
+
+// fnEncode encodes the value using standard base64 encoding
+type fnEncode struct {
+}
+
+func (*fnEncode) Name() string {
+	return "encode"
+}
+
+func (*fnEncode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnEncode) Eval(params ...interface{}) (interface{}, error) {
+	return base64.StdEncoding.EncodeToString(params[0].([]byte)), nil
+}
+
+// fnDecode decodes the standard base64 encoded string
+type fnDecode struct {
+}
+
+func (*fnDecode) Name() string {
+	return "decode"
+}
+
+func (*fnDecode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnDecode) Eval(params ...interface{}) (interface{}, error) {
+	b, err := base64.StdEncoding.DecodeString(params[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// fnEncodeURL encodes the value using the URL and file name safe base64 encoding
+type fnEncodeURL struct {
+}
+
+func (*fnEncodeURL) Name() string {
+	return "encodeURL"
+}
+
+func (*fnEncodeURL) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnEncodeURL) Eval(params ...interface{}) (interface{}, error) {
+	return base64.URLEncoding.EncodeToString(params[0].([]byte)), nil
+}
+
+// fnDecodeURL decodes the URL and file name safe base64 encoded string
+type fnDecodeURL struct {
+}
+
+func (*fnDecodeURL) Name() string {
+	return "decodeURL"
+}
+
+func (*fnDecodeURL) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnDecodeURL) Eval(params ...interface{}) (interface{}, error) {
+	b, err := base64.URLEncoding.DecodeString(params[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
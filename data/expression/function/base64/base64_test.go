@@ -0,0 +1,29 @@
+package base64
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnBase64_Eval(t *testing.T) {
+	v, err := function.Eval(&fnEncode{}, "flogo?>")
+	assert.Nil(t, err)
+	assert.Equal(t, "ZmxvZ28/Pg==", v)
+
+	v, err = function.Eval(&fnDecode{}, "ZmxvZ28/Pg==")
+	assert.Nil(t, err)
+	assert.Equal(t, "flogo?>", v)
+
+	v, err = function.Eval(&fnEncodeURL{}, []byte("flogo?>"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ZmxvZ28_Pg==", v)
+
+	v, err = function.Eval(&fnDecodeURL{}, "ZmxvZ28_Pg==")
+	assert.Nil(t, err)
+	assert.Equal(t, "flogo?>", v)
+
+	_, err = function.Eval(&fnDecode{}, "not base64!")
+	assert.NotNil(t, err)
+}
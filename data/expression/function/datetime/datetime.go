@@ -0,0 +1,237 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnNow{})
+	_ = function.Register(&fnParse{})
+	_ = function.Register(&fnFormat{})
+	_ = function.Register(&fnAdd{})
+	_ = function.Register(&fnAddDate{})
+	_ = function.Register(&fnDiff{})
+	_ = function.Register(&fnUnix{})
+	_ = function.Register(&fnFromUnix{})
+	_ = function.Register(&fnInLocation{})
+	_ = function.Register(&fnBefore{})
+	_ = function.Register(&fnAfter{})
+}
+
+// layouts are the named layouts that can be used instead of a Go layout
+var layouts = map[string]string{
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    "2006-01-02 15:04:05",
+	"Date":        "2006-01-02",
+	"Time":        "15:04:05",
+}
+
+func layout(name string) string {
+	if l, ok := layouts[name]; ok {
+		return l
+	}
+	return name
+}
+
+// fnNow returns the current date time
+type fnNow struct {
+}
+
+func (*fnNow) Name() string {
+	return "now"
+}
+
+func (*fnNow) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{}, false
+}
+
+func (*fnNow) Eval(params ...interface{}) (interface{}, error) {
+	return time.Now(), nil
+}
+
+// fnParse parses the date time, using the layout if specified, otherwise the format is detected
+// as done by coerce.ToDateTime
+type fnParse struct {
+}
+
+func (*fnParse) Name() string {
+	return "parse"
+}
+
+func (*fnParse) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, true
+}
+
+func (*fnParse) Eval(params ...interface{}) (interface{}, error) {
+	switch len(params) {
+	case 1:
+		return coerce.ToDateTime(params[0])
+	case 2:
+		return time.Parse(layout(params[1].(string)), params[0].(string))
+	default:
+		return nil, fmt.Errorf("parse function should have 1 or 2 arguments")
+	}
+}
+
+// fnFormat formats the date time using the layout
+type fnFormat struct {
+}
+
+func (*fnFormat) Name() string {
+	return "format"
+}
+
+func (*fnFormat) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeString}, false
+}
+
+func (*fnFormat) Eval(params ...interface{}) (interface{}, error) {
+	return params[0].(time.Time).Format(layout(params[1].(string))), nil
+}
+
+// fnAdd adds the duration, e.g. "1h30m" or "-15m", to the date time
+type fnAdd struct {
+}
+
+func (*fnAdd) Name() string {
+	return "add"
+}
+
+func (*fnAdd) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeString}, false
+}
+
+func (*fnAdd) Eval(params ...interface{}) (interface{}, error) {
+	d, err := time.ParseDuration(params[1].(string))
+	if err != nil {
+		return nil, err
+	}
+	return params[0].(time.Time).Add(d), nil
+}
+
+// fnAddDate adds the number of years, months and days to the date time
+type fnAddDate struct {
+}
+
+func (*fnAddDate) Name() string {
+	return "addDate"
+}
+
+func (*fnAddDate) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeInt, data.TypeInt, data.TypeInt}, false
+}
+
+func (*fnAddDate) Eval(params ...interface{}) (interface{}, error) {
+	return params[0].(time.Time).AddDate(params[1].(int), params[2].(int), params[3].(int)), nil
+}
+
+// fnDiff returns the number of seconds elapsed from the first to the second date time
+type fnDiff struct {
+}
+
+func (*fnDiff) Name() string {
+	return "diff"
+}
+
+func (*fnDiff) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeDateTime}, false
+}
+
+func (*fnDiff) Eval(params ...interface{}) (interface{}, error) {
+	return params[1].(time.Time).Sub(params[0].(time.Time)).Seconds(), nil
+}
+
+// fnUnix returns the date time as the number of seconds since the epoch
+type fnUnix struct {
+}
+
+func (*fnUnix) Name() string {
+	return "unix"
+}
+
+func (*fnUnix) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime}, false
+}
+
+func (*fnUnix) Eval(params ...interface{}) (interface{}, error) {
+	return params[0].(time.Time).Unix(), nil
+}
+
+// fnFromUnix returns the date time for the number of seconds since the epoch
+type fnFromUnix struct {
+}
+
+func (*fnFromUnix) Name() string {
+	return "fromUnix"
+}
+
+func (*fnFromUnix) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeInt64}, false
+}
+
+func (*fnFromUnix) Eval(params ...interface{}) (interface{}, error) {
+	return time.Unix(params[0].(int64), 0).UTC(), nil
+}
+
+// fnInLocation returns the date time in the time zone, e.g. "UTC" or "America/New_York"
+type fnInLocation struct {
+}
+
+func (*fnInLocation) Name() string {
+	return "inLocation"
+}
+
+func (*fnInLocation) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeString}, false
+}
+
+func (*fnInLocation) Eval(params ...interface{}) (interface{}, error) {
+	loc, err := time.LoadLocation(params[1].(string))
+	if err != nil {
+		return nil, err
+	}
+	return params[0].(time.Time).In(loc), nil
+}
+
+// fnBefore checks if the first date time is before the second
+type fnBefore struct {
+}
+
+func (*fnBefore) Name() string {
+	return "before"
+}
+
+func (*fnBefore) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeDateTime}, false
+}
+
+func (*fnBefore) Eval(params ...interface{}) (interface{}, error) {
+	return params[0].(time.Time).Before(params[1].(time.Time)), nil
+}
+
+// fnAfter checks if the first date time is after the second
+type fnAfter struct {
+}
+
+func (*fnAfter) Name() string {
+	return "after"
+}
+
+func (*fnAfter) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeDateTime, data.TypeDateTime}, false
+}
+
+func (*fnAfter) Eval(params ...interface{}) (interface{}, error) {
+	return params[0].(time.Time).After(params[1].(time.Time)), nil
+}
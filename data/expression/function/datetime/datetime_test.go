@@ -0,0 +1,80 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnParseFormat_Eval(t *testing.T) {
+	expected := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	v, err := function.Eval(&fnParse{}, "2021-03-04T05:06:07Z")
+	assert.Nil(t, err)
+	assert.True(t, expected.Equal(v.(time.Time)))
+
+	v, err = function.Eval(&fnParse{}, "04/03/2021 05:06:07", "02/01/2006 15:04:05")
+	assert.Nil(t, err)
+	assert.True(t, expected.Equal(v.(time.Time)))
+
+	_, err = function.Eval(&fnParse{}, "not a date")
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnFormat{}, expected, "Date")
+	assert.Nil(t, err)
+	assert.Equal(t, "2021-03-04", v)
+
+	v, err = function.Eval(&fnFormat{}, "2021-03-04T05:06:07Z", "15:04")
+	assert.Nil(t, err)
+	assert.Equal(t, "05:06", v)
+
+	v, err = function.Eval(&fnNow{})
+	assert.Nil(t, err)
+	assert.WithinDuration(t, time.Now(), v.(time.Time), time.Second)
+}
+
+func TestFnArith_Eval(t *testing.T) {
+	start := time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	v, err := function.Eval(&fnAdd{}, start, "1h30m")
+	assert.Nil(t, err)
+	assert.Equal(t, start.Add(90*time.Minute), v)
+
+	_, err = function.Eval(&fnAdd{}, start, "1 day")
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnAddDate{}, start, 0, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), v)
+
+	v, err = function.Eval(&fnDiff{}, start, "2021-01-31T00:01:00Z")
+	assert.Nil(t, err)
+	assert.Equal(t, 60.0, v)
+
+	v, err = function.Eval(&fnBefore{}, start, "2021-02-01T00:00:00Z")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = function.Eval(&fnAfter{}, start, "2021-02-01T00:00:00Z")
+	assert.Nil(t, err)
+	assert.Equal(t, false, v)
+}
+
+func TestFnUnix_Eval(t *testing.T) {
+	v, err := function.Eval(&fnUnix{}, "2021-01-01T00:00:00Z")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1609459200), v)
+
+	v, err = function.Eval(&fnFromUnix{}, "1609459200")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), v)
+
+	v, err = function.Eval(&fnInLocation{}, "2021-01-01T00:00:00Z", "UTC")
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, v.(time.Time).Location())
+
+	_, err = function.Eval(&fnInLocation{}, "2021-01-01T00:00:00Z", "Nowhere/Unknown")
+	assert.NotNil(t, err)
+}
@@ -13,6 +13,16 @@ type Function interface {
 	Eval(params ...interface{}) (interface{}, error)
 }
 
+// Lambda is an anonymous function passed as an argument to a function, e.g. x => x.price > 10
+type Lambda interface {
+	// Params returns the names of the parameters of the lambda
+	Params() []string
+
+	// Call evaluates the lambda, binding the arguments to its parameters in order. Missing
+	// arguments are nil and extra arguments are ignored
+	Call(args ...interface{}) (interface{}, error)
+}
+
 func Eval(f Function, params ...interface{}) (interface{}, error) {
 
 	paramTypes, isVariadic := f.Sig()
@@ -49,3 +49,24 @@ func TestFunction(t *testing.T) {
 	_, err = Eval(&SimpleFunction{})
 	assert.NotNil(t, err)
 }
+
+type OtherFunction struct {
+	SimpleFunction
+}
+
+func TestResolveAliasesPrecedence(t *testing.T) {
+	core := &SimpleFunction{}
+	installed := &OtherFunction{}
+
+	for i := 0; i < 10; i++ {
+		functionsTmp = map[string]Function{
+			"github.com/project-flogo/core/data/expression/function/number:override": core,
+			"github.com/example/function/number:override":                            installed,
+		}
+		SetPackageAlias("github.com/project-flogo/core/data/expression/function/number", "number")
+		SetPackageAlias("github.com/example/function/number", "number")
+
+		ResolveAliases()
+		assert.Same(t, installed, Get("number.override"))
+	}
+}
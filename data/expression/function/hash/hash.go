@@ -0,0 +1,107 @@
+package hash
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnMD5{})
+	_ = function.Register(&fnSHA1{})
+	_ = function.Register(&fnSHA256{})
+	_ = function.Register(&fnSHA512{})
+	_ = function.Register(&fnHMACSHA256{})
+}
+
+func sum(h hash.Hash, b []byte) string {
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fnMD5 returns the hex encoded MD5 checksum of the value
+type fnMD5 struct {
+}
+
+func (*fnMD5) Name() string {
+	return "md5"
+}
+
+func (*fnMD5) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnMD5) Eval(params ...interface{}) (interface{}, error) {
+	return sum(md5.New(), params[0].([]byte)), nil
+}
+
+// fnSHA1 returns the hex encoded SHA-1 checksum of the value
+type fnSHA1 struct {
+}
+
+func (*fnSHA1) Name() string {
+	return "sha1"
+}
+
+func (*fnSHA1) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnSHA1) Eval(params ...interface{}) (interface{}, error) {
+	return sum(sha1.New(), params[0].([]byte)), nil
+}
+
+// fnSHA256 returns the hex encoded SHA-256 checksum of the value
+type fnSHA256 struct {
+}
+
+func (*fnSHA256) Name() string {
+	return "sha256"
+}
+
+func (*fnSHA256) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnSHA256) Eval(params ...interface{}) (interface{}, error) {
+	return sum(sha256.New(), params[0].([]byte)), nil
+}
+
+// fnSHA512 returns the hex encoded SHA-512 checksum of the value
+type fnSHA512 struct {
+}
+
+func (*fnSHA512) Name() string {
+	return "sha512"
+}
+
+func (*fnSHA512) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes}, false
+}
+
+func (*fnSHA512) Eval(params ...interface{}) (interface{}, error) {
+	return sum(sha512.New(), params[0].([]byte)), nil
+}
+
+// fnHMACSHA256 returns the hex encoded HMAC-SHA256 of the value using the key
+type fnHMACSHA256 struct {
+}
+
+func (*fnHMACSHA256) Name() string {
+	return "hmacSha256"
+}
+
+func (*fnHMACSHA256) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeBytes, data.TypeBytes}, false
+}
+
+func (*fnHMACSHA256) Eval(params ...interface{}) (interface{}, error) {
+	return sum(hmac.New(sha256.New, params[1].([]byte)), params[0].([]byte)), nil
+}
@@ -0,0 +1,30 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnHash_Eval(t *testing.T) {
+	v, err := function.Eval(&fnMD5{}, "flogo")
+	assert.Nil(t, err)
+	assert.Len(t, v, 32)
+
+	v, err = function.Eval(&fnSHA1{}, "flogo")
+	assert.Nil(t, err)
+	assert.Len(t, v, 40)
+
+	v, err = function.Eval(&fnSHA256{}, "abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", v)
+
+	v, err = function.Eval(&fnSHA512{}, "flogo")
+	assert.Nil(t, err)
+	assert.Len(t, v, 128)
+
+	v, err = function.Eval(&fnHMACSHA256{}, "The quick brown fox jumps over the lazy dog", "key")
+	assert.Nil(t, err)
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", v)
+}
@@ -0,0 +1,72 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnStringify{})
+	_ = function.Register(&fnParse{})
+	_ = function.Register(&fnValid{})
+}
+
+// fnStringify returns the JSON representation of the value
+type fnStringify struct {
+}
+
+func (*fnStringify) Name() string {
+	return "stringify"
+}
+
+func (*fnStringify) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, false
+}
+
+func (*fnStringify) Eval(params ...interface{}) (interface{}, error) {
+	b, err := json.Marshal(params[0])
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// fnParse parses the JSON string
+type fnParse struct {
+}
+
+func (*fnParse) Name() string {
+	return "parse"
+}
+
+func (*fnParse) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnParse) Eval(params ...interface{}) (interface{}, error) {
+	var v interface{}
+	err := json.Unmarshal([]byte(params[0].(string)), &v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %s", err.Error())
+	}
+	return v, nil
+}
+
+// fnValid checks if the string is valid JSON
+type fnValid struct {
+}
+
+func (*fnValid) Name() string {
+	return "valid"
+}
+
+func (*fnValid) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnValid) Eval(params ...interface{}) (interface{}, error) {
+	return json.Valid([]byte(params[0].(string))), nil
+}
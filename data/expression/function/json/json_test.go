@@ -0,0 +1,63 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnJson_Eval(t *testing.T) {
+	v, err := function.Eval(&fnStringify{}, map[string]interface{}{"a": []int{1, 2}})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":[1,2]}`, v)
+
+	v, err = function.Eval(&fnParse{}, `{"a":[1,2]}`)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{1.0, 2.0}}, v)
+
+	_, err = function.Eval(&fnParse{}, `{"a":`)
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnValid{}, `{"a":`)
+	assert.Nil(t, err)
+	assert.Equal(t, false, v)
+}
+
+func TestFnJsonPath_Eval(t *testing.T) {
+	value := map[string]interface{}{"items": []interface{}{
+		map[string]interface{}{"id": 1, "price": 5},
+		map[string]interface{}{"id": 2, "price": 20},
+	}}
+
+	v, err := function.Eval(&fnPath{}, value, "$.items[1].id")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v)
+
+	v, err = function.Eval(&fnPath{}, value, "$.items[?(@.price > 10)].id")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{2}, v)
+
+	v, err = function.Eval(&fnPath{}, `{"a":{"b":"c"}}`, "$.a.b")
+	assert.Nil(t, err)
+	assert.Equal(t, "c", v)
+
+	v, err = function.Eval(&fnQuery{}, value, "$.items[0].id")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1}, v)
+
+	v, err = function.Eval(&fnQuery{}, value, "$..missing")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{}, v)
+
+	v, err = function.Eval(&fnExists{}, value, "$.items[?(@.id == 2)]")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = function.Eval(&fnExists{}, value, "$.items[5]")
+	assert.Nil(t, err)
+	assert.Equal(t, false, v)
+
+	_, err = function.Eval(&fnPath{}, value, "items[")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,73 @@
+package json
+
+import (
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/project-flogo/core/data/path"
+)
+
+func init() {
+	_ = function.Register(&fnPath{})
+	_ = function.Register(&fnQuery{})
+	_ = function.Register(&fnExists{})
+}
+
+// fnPath returns the value selected by a definite JSONPath query, ex. $.items[0].id, or an array of the values
+// selected by any other query
+type fnPath struct {
+}
+
+func (*fnPath) Name() string {
+	return "path"
+}
+
+func (*fnPath) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny, data.TypeString}, false
+}
+
+func (*fnPath) Eval(params ...interface{}) (interface{}, error) {
+	return path.GetJSONPathValue(params[0], params[1].(string))
+}
+
+// fnQuery returns an array of the values selected by a JSONPath query
+type fnQuery struct {
+}
+
+func (*fnQuery) Name() string {
+	return "query"
+}
+
+func (*fnQuery) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny, data.TypeString}, false
+}
+
+func (*fnQuery) Eval(params ...interface{}) (interface{}, error) {
+	results, err := path.QueryJSONPath(params[0], params[1].(string))
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		results = []interface{}{}
+	}
+	return results, nil
+}
+
+// fnExists checks if a JSONPath query selects any value
+type fnExists struct {
+}
+
+func (*fnExists) Name() string {
+	return "exists"
+}
+
+func (*fnExists) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny, data.TypeString}, false
+}
+
+func (*fnExists) Eval(params ...interface{}) (interface{}, error) {
+	results, err := path.QueryJSONPath(params[0], params[1].(string))
+	if err != nil {
+		return nil, err
+	}
+	return len(results) > 0, nil
+}
@@ -0,0 +1,292 @@
+package number
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnAbs{})
+	_ = function.Register(&fnCeil{})
+	_ = function.Register(&fnFloor{})
+	_ = function.Register(&fnRound{})
+	_ = function.Register(&fnMin{})
+	_ = function.Register(&fnMax{})
+	_ = function.Register(&fnSum{})
+	_ = function.Register(&fnAvg{})
+	_ = function.Register(&fnMod{})
+	_ = function.Register(&fnPow{})
+	_ = function.Register(&fnSqrt{})
+	_ = function.Register(&fnRandom{})
+}
+
+// fnAbs returns the absolute value of the number
+type fnAbs struct {
+}
+
+func (*fnAbs) Name() string {
+	return "abs"
+}
+
+func (*fnAbs) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64}, false
+}
+
+func (*fnAbs) Eval(params ...interface{}) (interface{}, error) {
+	return math.Abs(params[0].(float64)), nil
+}
+
+// fnCeil returns the least integer value greater than or equal to the number
+type fnCeil struct {
+}
+
+func (*fnCeil) Name() string {
+	return "ceil"
+}
+
+func (*fnCeil) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64}, false
+}
+
+func (*fnCeil) Eval(params ...interface{}) (interface{}, error) {
+	return int(math.Ceil(params[0].(float64))), nil
+}
+
+// fnFloor returns the greatest integer value less than or equal to the number
+type fnFloor struct {
+}
+
+func (*fnFloor) Name() string {
+	return "floor"
+}
+
+func (*fnFloor) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64}, false
+}
+
+func (*fnFloor) Eval(params ...interface{}) (interface{}, error) {
+	return int(math.Floor(params[0].(float64))), nil
+}
+
+// fnRound rounds the number half away from zero to the specified number of decimal places
+type fnRound struct {
+}
+
+func (*fnRound) Name() string {
+	return "round"
+}
+
+func (*fnRound) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64, data.TypeInt}, false
+}
+
+func (*fnRound) Eval(params ...interface{}) (interface{}, error) {
+	num, places := params[0].(float64), params[1].(int)
+	if places < 0 {
+		return nil, fmt.Errorf("round decimal places must not be negative")
+	}
+
+	shift := math.Pow(10, float64(places))
+	return math.Round(num*shift) / shift, nil
+}
+
+// fnMin returns the smallest of the numbers
+type fnMin struct {
+}
+
+func (*fnMin) Name() string {
+	return "min"
+}
+
+func (*fnMin) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnMin) Eval(params ...interface{}) (interface{}, error) {
+	nums, err := toNumbers("min", params)
+	if err != nil {
+		return nil, err
+	}
+
+	min := nums[0]
+	for _, n := range nums[1:] {
+		min = math.Min(min, n)
+	}
+	return min, nil
+}
+
+// fnMax returns the largest of the numbers
+type fnMax struct {
+}
+
+func (*fnMax) Name() string {
+	return "max"
+}
+
+func (*fnMax) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnMax) Eval(params ...interface{}) (interface{}, error) {
+	nums, err := toNumbers("max", params)
+	if err != nil {
+		return nil, err
+	}
+
+	max := nums[0]
+	for _, n := range nums[1:] {
+		max = math.Max(max, n)
+	}
+	return max, nil
+}
+
+// fnSum returns the sum of the numbers
+type fnSum struct {
+}
+
+func (*fnSum) Name() string {
+	return "sum"
+}
+
+func (*fnSum) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnSum) Eval(params ...interface{}) (interface{}, error) {
+	nums, err := toNumbers("sum", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum float64
+	for _, n := range nums {
+		sum += n
+	}
+	return sum, nil
+}
+
+// fnAvg returns the average of the numbers
+type fnAvg struct {
+}
+
+func (*fnAvg) Name() string {
+	return "avg"
+}
+
+func (*fnAvg) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnAvg) Eval(params ...interface{}) (interface{}, error) {
+	nums, err := toNumbers("avg", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum float64
+	for _, n := range nums {
+		sum += n
+	}
+	return sum / float64(len(nums)), nil
+}
+
+// toNumbers converts the params to numbers, a single array param is expanded
+func toNumbers(name string, params []interface{}) ([]float64, error) {
+	if len(params) == 1 {
+		if arr, err := coerce.ToArray(params[0]); err == nil {
+			params = arr
+		}
+	}
+
+	if len(params) == 0 {
+		return nil, fmt.Errorf("%s function requires at least one number", name)
+	}
+
+	nums := make([]float64, len(params))
+	for i, p := range params {
+		n, err := coerce.ToFloat64(p)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+// fnMod returns the remainder of the integer division
+type fnMod struct {
+}
+
+func (*fnMod) Name() string {
+	return "mod"
+}
+
+func (*fnMod) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeInt64, data.TypeInt64}, false
+}
+
+func (*fnMod) Eval(params ...interface{}) (interface{}, error) {
+	if params[1].(int64) == 0 {
+		return nil, fmt.Errorf("mod by zero")
+	}
+	return params[0].(int64) % params[1].(int64), nil
+}
+
+// fnPow returns the base raised to the power of the exponent
+type fnPow struct {
+}
+
+func (*fnPow) Name() string {
+	return "pow"
+}
+
+func (*fnPow) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64, data.TypeFloat64}, false
+}
+
+func (*fnPow) Eval(params ...interface{}) (interface{}, error) {
+	return math.Pow(params[0].(float64), params[1].(float64)), nil
+}
+
+// fnSqrt returns the square root of the number
+type fnSqrt struct {
+}
+
+func (*fnSqrt) Name() string {
+	return "sqrt"
+}
+
+func (*fnSqrt) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeFloat64}, false
+}
+
+func (*fnSqrt) Eval(params ...interface{}) (interface{}, error) {
+	if params[0].(float64) < 0 {
+		return nil, fmt.Errorf("square root of negative number %v", params[0])
+	}
+	return math.Sqrt(params[0].(float64)), nil
+}
+
+// fnRandom returns a random integer in [0,n)
+type fnRandom struct {
+}
+
+func (*fnRandom) Name() string {
+	return "random"
+}
+
+func (*fnRandom) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeInt}, false
+}
+
+func (*fnRandom) Eval(params ...interface{}) (interface{}, error) {
+	if params[0].(int) <= 0 {
+		return nil, fmt.Errorf("random bound must be positive")
+	}
+	return rand.Intn(params[0].(int)), nil
+}
@@ -0,0 +1,81 @@
+package number
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnRounding_Eval(t *testing.T) {
+	v, err := function.Eval(&fnAbs{}, -2.5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, v)
+
+	v, err = function.Eval(&fnCeil{}, "2.1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, v)
+
+	v, err = function.Eval(&fnFloor{}, -2.1)
+	assert.Nil(t, err)
+	assert.Equal(t, -3, v)
+
+	v, err = function.Eval(&fnRound{}, 2.345, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.35, v)
+
+	v, err = function.Eval(&fnRound{}, 2.5, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3.0, v)
+}
+
+func TestFnAggregate_Eval(t *testing.T) {
+	v, err := function.Eval(&fnMin{}, 3, "1", 2.5)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, v)
+
+	v, err = function.Eval(&fnMax{}, []interface{}{3, 7.5, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 7.5, v)
+
+	v, err = function.Eval(&fnSum{}, []int{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, 6.0, v)
+
+	v, err = function.Eval(&fnAvg{}, 1, 2, 3, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, v)
+
+	_, err = function.Eval(&fnMin{})
+	assert.NotNil(t, err)
+
+	_, err = function.Eval(&fnSum{}, "abc")
+	assert.NotNil(t, err)
+}
+
+func TestFnArith_Eval(t *testing.T) {
+	v, err := function.Eval(&fnMod{}, 7, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), v)
+
+	_, err = function.Eval(&fnMod{}, 7, 0)
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnPow{}, 2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1024.0, v)
+
+	v, err = function.Eval(&fnSqrt{}, 16)
+	assert.Nil(t, err)
+	assert.Equal(t, 4.0, v)
+
+	_, err = function.Eval(&fnSqrt{}, -1)
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnRandom{}, 10)
+	assert.Nil(t, err)
+	assert.True(t, v.(int) >= 0 && v.(int) < 10)
+
+	_, err = function.Eval(&fnRandom{}, 0)
+	assert.NotNil(t, err)
+}
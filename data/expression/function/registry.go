@@ -9,6 +9,8 @@ import (
 	"github.com/project-flogo/core/support/log"
 )
 
+const corePackagePrefix = "github.com/project-flogo/core/"
+
 var (
 	functions    = make(map[string]Function)
 	functionsTmp = make(map[string]Function)
@@ -76,6 +78,8 @@ func ResolveAliases() {
 		return
 	}
 
+	resolvedPkgs := make(map[string]string)
+
 	for key, f := range functionsTmp {
 
 		parts := strings.Split(key, ":")
@@ -83,10 +87,22 @@ func ResolveAliases() {
 		name := parts[1]
 		alias := packages[pkg]
 		id := alias + "." + name
+
+		// functions installed from other packages take precedence over the core functions with the same alias
+		if existingPkg, exists := resolvedPkgs[id]; exists && !isCorePackage(existingPkg) && isCorePackage(pkg) {
+			log.RootLogger().Debugf("Function '%s' of '%s' overrides core function '%s'", id, existingPkg, key)
+			continue
+		}
+
 		functions[id] = f
+		resolvedPkgs[id] = pkg
 		log.RootLogger().Debugf("Resolved function '%s' to '%s", key, id)
 	}
 
 	//remove temp function holder
 	functionsTmp = nil
 }
+
+func isCorePackage(pkg string) bool {
+	return strings.HasPrefix(pkg, corePackagePrefix)
+}
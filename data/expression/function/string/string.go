@@ -0,0 +1,435 @@
+package string
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnConcat{})
+	_ = function.Register(&fnContains{})
+	_ = function.Register(&fnStartsWith{})
+	_ = function.Register(&fnEndsWith{})
+	_ = function.Register(&fnIndexOf{})
+	_ = function.Register(&fnLastIndexOf{})
+	_ = function.Register(&fnSubstring{})
+	_ = function.Register(&fnToUpper{})
+	_ = function.Register(&fnToLower{})
+	_ = function.Register(&fnTrim{})
+	_ = function.Register(&fnTrimPrefix{})
+	_ = function.Register(&fnTrimSuffix{})
+	_ = function.Register(&fnReplace{})
+	_ = function.Register(&fnSplit{})
+	_ = function.Register(&fnJoin{})
+	_ = function.Register(&fnPadLeft{})
+	_ = function.Register(&fnPadRight{})
+	_ = function.Register(&fnRepeat{})
+	_ = function.Register(&fnMatches{})
+	_ = function.Register(&fnEqualsIgnoreCase{})
+}
+
+// fnConcat concatenates the strings
+type fnConcat struct {
+}
+
+func (*fnConcat) Name() string {
+	return "concat"
+}
+
+func (*fnConcat) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, true
+}
+
+func (*fnConcat) Eval(params ...interface{}) (interface{}, error) {
+	var b strings.Builder
+	for _, p := range params {
+		b.WriteString(p.(string))
+	}
+	return b.String(), nil
+}
+
+// fnContains checks if the string contains the substring
+type fnContains struct {
+}
+
+func (*fnContains) Name() string {
+	return "contains"
+}
+
+func (*fnContains) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnContains) Eval(params ...interface{}) (interface{}, error) {
+	return strings.Contains(params[0].(string), params[1].(string)), nil
+}
+
+// fnStartsWith checks if the string starts with the prefix
+type fnStartsWith struct {
+}
+
+func (*fnStartsWith) Name() string {
+	return "startsWith"
+}
+
+func (*fnStartsWith) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnStartsWith) Eval(params ...interface{}) (interface{}, error) {
+	return strings.HasPrefix(params[0].(string), params[1].(string)), nil
+}
+
+// fnEndsWith checks if the string ends with the suffix
+type fnEndsWith struct {
+}
+
+func (*fnEndsWith) Name() string {
+	return "endsWith"
+}
+
+func (*fnEndsWith) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnEndsWith) Eval(params ...interface{}) (interface{}, error) {
+	return strings.HasSuffix(params[0].(string), params[1].(string)), nil
+}
+
+// fnIndexOf returns the index of the first occurrence of the substring, or -1
+type fnIndexOf struct {
+}
+
+func (*fnIndexOf) Name() string {
+	return "indexOf"
+}
+
+func (*fnIndexOf) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnIndexOf) Eval(params ...interface{}) (interface{}, error) {
+	return runeIndex(params[0].(string), strings.Index(params[0].(string), params[1].(string))), nil
+}
+
+// fnLastIndexOf returns the index of the last occurrence of the substring, or -1
+type fnLastIndexOf struct {
+}
+
+func (*fnLastIndexOf) Name() string {
+	return "lastIndexOf"
+}
+
+func (*fnLastIndexOf) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnLastIndexOf) Eval(params ...interface{}) (interface{}, error) {
+	return runeIndex(params[0].(string), strings.LastIndex(params[0].(string), params[1].(string))), nil
+}
+
+// runeIndex converts a byte index in the string to a character index
+func runeIndex(s string, idx int) int {
+	if idx <= 0 {
+		return idx
+	}
+	return len([]rune(s[:idx]))
+}
+
+// fnSubstring returns the characters of the string starting at the index, optionally limited to a length
+type fnSubstring struct {
+}
+
+func (*fnSubstring) Name() string {
+	return "substring"
+}
+
+func (*fnSubstring) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeAny}, true
+}
+
+func (*fnSubstring) Eval(params ...interface{}) (interface{}, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return nil, fmt.Errorf("substring function should have 2 or 3 arguments")
+	}
+
+	s, err := coerce.ToString(params[0])
+	if err != nil {
+		return nil, err
+	}
+	start, err := coerce.ToInt(params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(s)
+	if start < 0 || start > len(runes) {
+		return nil, fmt.Errorf("substring start index %d out of range [0,%d]", start, len(runes))
+	}
+
+	end := len(runes)
+	if len(params) == 3 {
+		length, err := coerce.ToInt(params[2])
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, fmt.Errorf("substring length must not be negative")
+		}
+		if start+length < end {
+			end = start + length
+		}
+	}
+
+	return string(runes[start:end]), nil
+}
+
+// fnToUpper converts the string to upper case
+type fnToUpper struct {
+}
+
+func (*fnToUpper) Name() string {
+	return "toUpper"
+}
+
+func (*fnToUpper) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnToUpper) Eval(params ...interface{}) (interface{}, error) {
+	return strings.ToUpper(params[0].(string)), nil
+}
+
+// fnToLower converts the string to lower case
+type fnToLower struct {
+}
+
+func (*fnToLower) Name() string {
+	return "toLower"
+}
+
+func (*fnToLower) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnToLower) Eval(params ...interface{}) (interface{}, error) {
+	return strings.ToLower(params[0].(string)), nil
+}
+
+// fnTrim removes the leading and trailing white space of the string
+type fnTrim struct {
+}
+
+func (*fnTrim) Name() string {
+	return "trim"
+}
+
+func (*fnTrim) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnTrim) Eval(params ...interface{}) (interface{}, error) {
+	return strings.TrimSpace(params[0].(string)), nil
+}
+
+// fnTrimPrefix removes the prefix from the string
+type fnTrimPrefix struct {
+}
+
+func (*fnTrimPrefix) Name() string {
+	return "trimPrefix"
+}
+
+func (*fnTrimPrefix) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnTrimPrefix) Eval(params ...interface{}) (interface{}, error) {
+	return strings.TrimPrefix(params[0].(string), params[1].(string)), nil
+}
+
+// fnTrimSuffix removes the suffix from the string
+type fnTrimSuffix struct {
+}
+
+func (*fnTrimSuffix) Name() string {
+	return "trimSuffix"
+}
+
+func (*fnTrimSuffix) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnTrimSuffix) Eval(params ...interface{}) (interface{}, error) {
+	return strings.TrimSuffix(params[0].(string), params[1].(string)), nil
+}
+
+// fnReplace replaces all the occurrences of a substring
+type fnReplace struct {
+}
+
+func (*fnReplace) Name() string {
+	return "replace"
+}
+
+func (*fnReplace) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString, data.TypeString}, false
+}
+
+func (*fnReplace) Eval(params ...interface{}) (interface{}, error) {
+	return strings.ReplaceAll(params[0].(string), params[1].(string), params[2].(string)), nil
+}
+
+// fnSplit splits the string around the separator
+type fnSplit struct {
+}
+
+func (*fnSplit) Name() string {
+	return "split"
+}
+
+func (*fnSplit) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnSplit) Eval(params ...interface{}) (interface{}, error) {
+	parts := strings.Split(params[0].(string), params[1].(string))
+	result := make([]interface{}, len(parts))
+	for i, part := range parts {
+		result[i] = part
+	}
+	return result, nil
+}
+
+// fnJoin joins the elements of the array using the separator
+type fnJoin struct {
+}
+
+func (*fnJoin) Name() string {
+	return "join"
+}
+
+func (*fnJoin) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeArray, data.TypeString}, false
+}
+
+func (*fnJoin) Eval(params ...interface{}) (interface{}, error) {
+	arr, err := coerce.ToArray(params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]string, len(arr))
+	for i, v := range arr {
+		parts[i], err = coerce.ToString(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return strings.Join(parts, params[1].(string)), nil
+}
+
+// fnPadLeft pads the start of the string with the pad string until it has the specified length
+type fnPadLeft struct {
+}
+
+func (*fnPadLeft) Name() string {
+	return "padLeft"
+}
+
+func (*fnPadLeft) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeInt, data.TypeString}, false
+}
+
+func (*fnPadLeft) Eval(params ...interface{}) (interface{}, error) {
+	s, pad := params[0].(string), padding(params[0].(string), params[1].(int), params[2].(string))
+	return pad + s, nil
+}
+
+// fnPadRight pads the end of the string with the pad string until it has the specified length
+type fnPadRight struct {
+}
+
+func (*fnPadRight) Name() string {
+	return "padRight"
+}
+
+func (*fnPadRight) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeInt, data.TypeString}, false
+}
+
+func (*fnPadRight) Eval(params ...interface{}) (interface{}, error) {
+	s, pad := params[0].(string), padding(params[0].(string), params[1].(int), params[2].(string))
+	return s + pad, nil
+}
+
+func padding(s string, length int, pad string) string {
+	missing := length - len([]rune(s))
+	if missing <= 0 || pad == "" {
+		return ""
+	}
+
+	padRunes := []rune(strings.Repeat(pad, missing/len([]rune(pad))+1))
+	return string(padRunes[:missing])
+}
+
+// fnRepeat repeats the string count times
+type fnRepeat struct {
+}
+
+func (*fnRepeat) Name() string {
+	return "repeat"
+}
+
+func (*fnRepeat) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeInt}, false
+}
+
+func (*fnRepeat) Eval(params ...interface{}) (interface{}, error) {
+	count := params[1].(int)
+	if count < 0 {
+		return nil, fmt.Errorf("repeat count must not be negative")
+	}
+	return strings.Repeat(params[0].(string), count), nil
+}
+
+// fnMatches checks if the string matches the regular expression
+type fnMatches struct {
+}
+
+func (*fnMatches) Name() string {
+	return "matches"
+}
+
+func (*fnMatches) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnMatches) Eval(params ...interface{}) (interface{}, error) {
+	re, err := regexp.Compile(params[1].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %s", params[1], err.Error())
+	}
+	return re.MatchString(params[0].(string)), nil
+}
+
+// fnEqualsIgnoreCase checks if the strings are equal ignoring case
+type fnEqualsIgnoreCase struct {
+}
+
+func (*fnEqualsIgnoreCase) Name() string {
+	return "equalsIgnoreCase"
+}
+
+func (*fnEqualsIgnoreCase) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString, data.TypeString}, false
+}
+
+func (*fnEqualsIgnoreCase) Eval(params ...interface{}) (interface{}, error) {
+	return strings.EqualFold(params[0].(string), params[1].(string)), nil
+}
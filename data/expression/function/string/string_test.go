@@ -0,0 +1,127 @@
+package string
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnConcat_Eval(t *testing.T) {
+	v, err := function.Eval(&fnConcat{}, "a", 1, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "a1true", v)
+}
+
+func TestFnSearch_Eval(t *testing.T) {
+	v, err := function.Eval(&fnContains{}, "flogo", "log")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = function.Eval(&fnStartsWith{}, "flogo", "fl")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = function.Eval(&fnEndsWith{}, "flogo", "fl")
+	assert.Nil(t, err)
+	assert.Equal(t, false, v)
+
+	v, err = function.Eval(&fnIndexOf{}, "héllo hello", "llo")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v)
+
+	v, err = function.Eval(&fnLastIndexOf{}, "héllo hello", "llo")
+	assert.Nil(t, err)
+	assert.Equal(t, 8, v)
+
+	v, err = function.Eval(&fnIndexOf{}, "flogo", "x")
+	assert.Nil(t, err)
+	assert.Equal(t, -1, v)
+
+	v, err = function.Eval(&fnMatches{}, "order-123", `^order-\d+$`)
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	_, err = function.Eval(&fnMatches{}, "order-123", `(`)
+	assert.NotNil(t, err)
+
+	v, err = function.Eval(&fnEqualsIgnoreCase{}, "Flogo", "FLOGO")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+}
+
+func TestFnSubstring_Eval(t *testing.T) {
+	v, err := function.Eval(&fnSubstring{}, "héllo world", 1, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, "éllo", v)
+
+	v, err = function.Eval(&fnSubstring{}, "hello world", "6")
+	assert.Nil(t, err)
+	assert.Equal(t, "world", v)
+
+	v, err = function.Eval(&fnSubstring{}, "hello", 3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, "lo", v)
+
+	_, err = function.Eval(&fnSubstring{}, "hello", 6)
+	assert.NotNil(t, err)
+
+	_, err = function.Eval(&fnSubstring{}, "hello")
+	assert.NotNil(t, err)
+}
+
+func TestFnTransform_Eval(t *testing.T) {
+	v, err := function.Eval(&fnToUpper{}, "flogo")
+	assert.Nil(t, err)
+	assert.Equal(t, "FLOGO", v)
+
+	v, err = function.Eval(&fnToLower{}, "FLOGO")
+	assert.Nil(t, err)
+	assert.Equal(t, "flogo", v)
+
+	v, err = function.Eval(&fnTrim{}, "  flogo \n")
+	assert.Nil(t, err)
+	assert.Equal(t, "flogo", v)
+
+	v, err = function.Eval(&fnTrimPrefix{}, "v1.2", "v")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.2", v)
+
+	v, err = function.Eval(&fnTrimSuffix{}, "file.json", ".json")
+	assert.Nil(t, err)
+	assert.Equal(t, "file", v)
+
+	v, err = function.Eval(&fnReplace{}, "a-b-c", "-", "+")
+	assert.Nil(t, err)
+	assert.Equal(t, "a+b+c", v)
+
+	v, err = function.Eval(&fnRepeat{}, "ab", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "ababab", v)
+
+	v, err = function.Eval(&fnPadLeft{}, "42", 5, "0")
+	assert.Nil(t, err)
+	assert.Equal(t, "00042", v)
+
+	v, err = function.Eval(&fnPadRight{}, "ab", 7, "xy")
+	assert.Nil(t, err)
+	assert.Equal(t, "abxyxyx", v)
+
+	v, err = function.Eval(&fnPadLeft{}, "flogo", 3, "0")
+	assert.Nil(t, err)
+	assert.Equal(t, "flogo", v)
+}
+
+func TestFnSplitJoin_Eval(t *testing.T) {
+	v, err := function.Eval(&fnSplit{}, "a,b,c", ",")
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", "b", "c"}, v)
+
+	v, err = function.Eval(&fnJoin{}, []interface{}{"a", 1, true}, "-")
+	assert.Nil(t, err)
+	assert.Equal(t, "a-1-true", v)
+
+	v, err = function.Eval(&fnJoin{}, []string{"a", "b"}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "ab", v)
+}
@@ -0,0 +1,122 @@
+package url
+
+import (
+	"net/url"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+)
+
+func init() {
+	_ = function.Register(&fnEncode{})
+	_ = function.Register(&fnDecode{})
+	_ = function.Register(&fnPathEncode{})
+	_ = function.Register(&fnPathDecode{})
+	_ = function.Register(&fnParse{})
+}
+
+// fnEncode escapes the string so it can be used in a URL query
+type fnEncode struct {
+}
+
+func (*fnEncode) Name() string {
+	return "encode"
+}
+
+func (*fnEncode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnEncode) Eval(params ...interface{}) (interface{}, error) {
+	return url.QueryEscape(params[0].(string)), nil
+}
+
+// fnDecode unescapes the URL query string
+type fnDecode struct {
+}
+
+func (*fnDecode) Name() string {
+	return "decode"
+}
+
+func (*fnDecode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnDecode) Eval(params ...interface{}) (interface{}, error) {
+	return url.QueryUnescape(params[0].(string))
+}
+
+// fnPathEncode escapes the string so it can be used as a URL path segment
+type fnPathEncode struct {
+}
+
+func (*fnPathEncode) Name() string {
+	return "pathEncode"
+}
+
+func (*fnPathEncode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnPathEncode) Eval(params ...interface{}) (interface{}, error) {
+	return url.PathEscape(params[0].(string)), nil
+}
+
+// fnPathDecode unescapes the URL path segment
+type fnPathDecode struct {
+}
+
+func (*fnPathDecode) Name() string {
+	return "pathDecode"
+}
+
+func (*fnPathDecode) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnPathDecode) Eval(params ...interface{}) (interface{}, error) {
+	return url.PathUnescape(params[0].(string))
+}
+
+// fnParse parses the URL into an object with the scheme, host, port, path, query, fragment and user
+type fnParse struct {
+}
+
+func (*fnParse) Name() string {
+	return "parse"
+}
+
+func (*fnParse) Sig() (paramTypes []data.Type, isVariadic bool) {
+	return []data.Type{data.TypeString}, false
+}
+
+func (*fnParse) Eval(params ...interface{}) (interface{}, error) {
+	u, err := url.Parse(params[0].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	query := make(map[string]interface{})
+	for k, v := range u.Query() {
+		if len(v) == 1 {
+			query[k] = v[0]
+		} else {
+			values := make([]interface{}, len(v))
+			for i, value := range v {
+				values[i] = value
+			}
+			query[k] = values
+		}
+	}
+
+	return map[string]interface{}{
+		"scheme":   u.Scheme,
+		"host":     u.Hostname(),
+		"port":     u.Port(),
+		"path":     u.Path,
+		"query":    query,
+		"fragment": u.Fragment,
+		"user":     u.User.Username(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package url
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFnEscape_Eval(t *testing.T) {
+	v, err := function.Eval(&fnEncode{}, "a b&c")
+	assert.Nil(t, err)
+	assert.Equal(t, "a+b%26c", v)
+
+	v, err = function.Eval(&fnDecode{}, "a+b%26c")
+	assert.Nil(t, err)
+	assert.Equal(t, "a b&c", v)
+
+	v, err = function.Eval(&fnPathEncode{}, "a b/c")
+	assert.Nil(t, err)
+	assert.Equal(t, "a%20b%2Fc", v)
+
+	v, err = function.Eval(&fnPathDecode{}, "a%20b%2Fc")
+	assert.Nil(t, err)
+	assert.Equal(t, "a b/c", v)
+}
+
+func TestFnParse_Eval(t *testing.T) {
+	v, err := function.Eval(&fnParse{}, "https://user@flogo.io:8443/docs?q=1&tag=a&tag=b#top")
+	assert.Nil(t, err)
+
+	u := v.(map[string]interface{})
+	assert.Equal(t, "https", u["scheme"])
+	assert.Equal(t, "flogo.io", u["host"])
+	assert.Equal(t, "8443", u["port"])
+	assert.Equal(t, "/docs", u["path"])
+	assert.Equal(t, "top", u["fragment"])
+	assert.Equal(t, "user", u["user"])
+	assert.Equal(t, map[string]interface{}{"q": "1", "tag": []interface{}{"a", "b"}}, u["query"])
+}
@@ -0,0 +1,134 @@
+package script
+
+import (
+	"container/list"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+const (
+	EnvExprCacheSize     = "FLOGO_EXPR_CACHE_SIZE"
+	DefaultExprCacheSize = 1024
+)
+
+// compiled is the global cache of compiled expressions, expressions are immutable once initialized (Eval does
+// not modify the nodes) so they can be shared by all the mappers and handlers that use the same expression text
+// and resolver.  Comparisons, matches and functions at the root of an expression record their last evaluation,
+// which is reported by their Detail, so they are not cached.
+var compiled = newExprCache(exprCacheSizeFromEnv())
+
+func exprCacheSizeFromEnv() int {
+	size := DefaultExprCacheSize
+	if v, ok := os.LookupEnv(EnvExprCacheSize); ok {
+		if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+			size = s
+		}
+	}
+	return size
+}
+
+// SetExprCacheSize sets the maximum number of compiled expressions that are cached, 0 disables the cache
+func SetExprCacheSize(size int) {
+	compiled.resize(size)
+}
+
+type cacheKey struct {
+	resolver resolve.CompositeResolver
+	expr     string
+}
+
+type cacheEntry struct {
+	key  cacheKey
+	expr expression.Expr
+}
+
+// exprCache is a bounded cache of compiled expressions, the least recently used expression is evicted
+// when the cache is full
+type exprCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+
+	hits, misses uint64
+}
+
+func newExprCache(size int) *exprCache {
+	return &exprCache{size: size, entries: make(map[cacheKey]*list.Element), lru: list.New()}
+}
+
+func (c *exprCache) get(key cacheKey) (expression.Expr, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.hits++
+		return elem.Value.(*cacheEntry).expr, true
+	}
+	c.misses++
+	return nil, false
+}
+
+func (c *exprCache) put(key cacheKey, expr expression.Expr) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		elem.Value.(*cacheEntry).expr = expr
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, expr: expr})
+	c.evict()
+}
+
+func (c *exprCache) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size = size
+	c.evict()
+}
+
+func (c *exprCache) evict() {
+	for c.lru.Len() > c.size {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.entries, elem.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *exprCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// staticTracker records whether an expression references static values, ex. $property[name], these are
+// resolved when the expression is initialized and can change when the app is reconfigured, so expressions
+// referencing them are not cached
+type staticTracker struct {
+	resolve.CompositeResolver
+	static int32
+}
+
+func (t *staticTracker) GetResolution(directive string) (resolve.Resolution, error) {
+	res, err := t.CompositeResolver.GetResolution(directive)
+	if err == nil && res.IsStatic() {
+		atomic.StoreInt32(&t.static, 1)
+	}
+	return res, err
+}
+
+func (t *staticTracker) isStatic() bool {
+	return atomic.LoadInt32(&t.static) == 1
+}
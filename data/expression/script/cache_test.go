@@ -0,0 +1,240 @@
+package script
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/expression/script/gocc/ast"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExprCache(t *testing.T) {
+	resolver := resolve.NewCompositeResolver(map[string]resolve.Resolver{".": &resolve.ScopeResolver{}, "env": &resolve.EnvResolver{}})
+	factory := NewExprFactory(resolver)
+
+	e1, err := factory.NewExpr(`$.a + 1`)
+	assert.Nil(t, err)
+	e2, err := NewExprFactory(resolver).NewExpr(`$.a + 1`)
+	assert.Nil(t, err)
+	assert.True(t, e1 == e2)
+
+	// the resolver is part of the key
+	e3, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(`$.a + 1`)
+	assert.Nil(t, err)
+	assert.False(t, e1 == e3)
+
+	// expressions with static references are resolved on creation, so they are not cached
+	e1, err = factory.NewExpr(`$env[PATH] + "x"`)
+	assert.Nil(t, err)
+	e2, err = factory.NewExpr(`$env[PATH] + "x"`)
+	assert.Nil(t, err)
+	assert.False(t, e1 == e2)
+
+	// invalid expressions are not cached
+	_, err = factory.NewExpr(`$.a +`)
+	assert.NotNil(t, err)
+	_, err = factory.NewExpr(`$.a +`)
+	assert.NotNil(t, err)
+
+	SetExprCacheSize(0)
+	defer SetExprCacheSize(DefaultExprCacheSize)
+	assert.Equal(t, 0, compiled.len())
+
+	e1, err = factory.NewExpr(`$.a + 1`)
+	assert.Nil(t, err)
+	e2, err = factory.NewExpr(`$.a + 1`)
+	assert.Nil(t, err)
+	assert.False(t, e1 == e2)
+}
+
+func TestExprCacheEviction(t *testing.T) {
+	cache := newExprCache(2)
+	a, b, c := cacheKey{expr: "a"}, cacheKey{expr: "b"}, cacheKey{expr: "c"}
+	ea, eb, ec := &testExpr{}, &testExpr{}, &testExpr{}
+
+	cache.put(a, ea)
+	cache.put(b, eb)
+	_, ok := cache.get(a)
+	assert.True(t, ok)
+
+	// b is the least recently used
+	cache.put(c, ec)
+	assert.Equal(t, 2, cache.len())
+	_, ok = cache.get(b)
+	assert.False(t, ok)
+	expr, ok := cache.get(a)
+	assert.True(t, ok)
+	assert.True(t, expr == ea)
+	assert.Equal(t, uint64(2), cache.hits)
+	assert.Equal(t, uint64(1), cache.misses)
+
+	cache.resize(1)
+	assert.Equal(t, 1, cache.len())
+	_, ok = cache.get(a)
+	assert.True(t, ok)
+}
+
+// cached expressions are shared, run with -race to check they can be evaluated concurrently
+func TestExprCacheConcurrentEval(t *testing.T) {
+	// nested comparisons, matches and functions are shared, they don't record their evaluation
+	exprStrs := []string{`$.a == 1 || false`, `$.a != 1 || false`, `$.a > 1 || false`, `$.a >= 1 || false`,
+		`$.a < 1 || false`, `$.a <= 1 || false`, `$.s startsWith "a" || false`, `$.s =~ "^a" || false`,
+		`[string.concat($.s, "b")]`, `$.arr[$.a]`, `$.a + 1`}
+
+	var exprs []expression.Expr
+	for _, exprStr := range exprStrs {
+		expr, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		shared, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		assert.True(t, expr == shared, exprStr)
+		exprs = append(exprs, expr)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scope := data.NewSimpleScope(map[string]interface{}{"a": i % 2, "s": "abc", "arr": []interface{}{"x", "y"}}, nil)
+			for n := 0; n < 100; n++ {
+				for _, expr := range exprs {
+					_, err := expr.Eval(scope)
+					assert.Nil(t, err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestExprCacheDetail(t *testing.T) {
+	testcases := map[string]ast.ExprEvalData{
+		`$.a == 1`:                {ExpressionType: "operator", ExpressionName: "equal to", ExpressionEvaluation: "2 == 1"},
+		`$.a >= 1`:                {ExpressionType: "operator", ExpressionName: "greater than equal to", ExpressionEvaluation: "2 >= 1"},
+		`$.s startsWith "a"`:      {ExpressionType: "operator", ExpressionName: "starts with", ExpressionEvaluation: "abc startsWith a"},
+		`string.concat($.s, "b")`: {ExpressionType: "function", ExpressionName: "concat", ExpressionEvaluation: "abcb"},
+	}
+
+	scope := data.NewSimpleScope(map[string]interface{}{"a": 2, "s": "abc"}, nil)
+	for exprStr, expected := range testcases {
+		expr, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		other, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		assert.False(t, expr == other, exprStr)
+
+		_, err = expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, expected, expr.(ast.ExprEvalResult).Detail(), exprStr)
+		// the other expression was not evaluated
+		assert.Equal(t, "", other.(ast.ExprEvalResult).Detail().ExpressionEvaluation, exprStr)
+	}
+}
+
+type testExpr struct {
+	id int
+}
+
+func (e *testExpr) Eval(scope data.Scope) (interface{}, error) {
+	return e.id, nil
+}
+
+func TestFastPaths(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"i": 500, "j": 7, "f": 2.5, "g": 0.5, "s": "abc", "b": true, "i64": int64(3)}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := map[string]interface{}{
+		`$.i + $.j`:     507,
+		`$.i - $.j`:     493,
+		`$.i * $.j`:     3500,
+		`$.i / $.j`:     71,
+		`$.i % $.j`:     3,
+		`$.f + $.g`:     3.0,
+		`$.f / $.g`:     5.0,
+		`$.f % 2.0`:     0,
+		`$.s + "def"`:   "abcdef",
+		`$.i > $.j`:     true,
+		`$.i <= $.j`:    false,
+		`$.i64 == 3`:    true,
+		`$.f >= $.g`:    true,
+		`$.s < "abd"`:   true,
+		`$.s != "abc"`:  false,
+		`$.b == true`:   true,
+		`$.i + $.f`:     502.5,
+		`$.i == $.i64`:  false,
+		`$.s == $.i`:    false,
+		`$.i64 + $.i64`: 6,
+		`$.b != false`:  true,
+	}
+
+	for exprStr, expected := range testcases {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		v, err := expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, expected, v, exprStr)
+	}
+
+	// comparisons of values of the same type do not allocate
+	for _, exprStr := range []string{`$.i > $.j`, `$.s == "abc"`, `$.f < $.g`, `$.b == true && $.i != 5`} {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err)
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = expr.Eval(scope)
+		})
+		assert.Equal(t, 0.0, allocs, exprStr)
+	}
+}
+
+func BenchmarkNewExprCached(b *testing.B) {
+	factory := NewExprFactory(resolve.GetBasicResolver())
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		result, _ = factory.NewExpr(`$.price * $.quantity > 100 && $.status == "open"`)
+	}
+}
+
+func BenchmarkNewExprUncached(b *testing.B) {
+	SetExprCacheSize(0)
+	defer SetExprCacheSize(DefaultExprCacheSize)
+
+	factory := NewExprFactory(resolve.GetBasicResolver())
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		result, _ = factory.NewExpr(`$.price * $.quantity > 100 && $.status == "open"`)
+	}
+}
+
+func benchmarkEval(b *testing.B, exprStr string, values map[string]interface{}) {
+	expr, err := NewExprFactory(resolve.GetBasicResolver()).NewExpr(exprStr)
+	if err != nil {
+		b.Fatal(err)
+	}
+	scope := data.NewSimpleScope(values, nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		result, _ = expr.Eval(scope)
+	}
+}
+
+func BenchmarkEvalCompareInt(b *testing.B) {
+	benchmarkEval(b, `$.a > $.b`, map[string]interface{}{"a": 1000, "b": 10})
+}
+
+func BenchmarkEvalCompareMixed(b *testing.B) {
+	// int and int32 operands take the general path
+	benchmarkEval(b, `$.a > $.b`, map[string]interface{}{"a": 1000, "b": int32(10)})
+}
+
+func BenchmarkEvalCompareString(b *testing.B) {
+	benchmarkEval(b, `$.a == "open"`, map[string]interface{}{"a": "open"})
+}
+
+func BenchmarkEvalArithFloat(b *testing.B) {
+	benchmarkEval(b, `$.a * $.b + $.c`, map[string]interface{}{"a": 1.5, "b": 2.5, "c": 10.0})
+}
@@ -0,0 +1,76 @@
+package script
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckExpr(t *testing.T) {
+	env := &expression.CheckEnv{Scope: map[string]data.TypedValue{
+		"name":  data.NewAttribute("name", data.TypeString, nil),
+		"count": data.NewAttribute("count", data.TypeInt, nil),
+		"price": data.NewAttribute("price", data.TypeFloat64, nil),
+		"order": data.NewAttribute("order", data.TypeObject, nil),
+		"items": data.NewAttribute("items", data.TypeArray, nil),
+		"flag":  data.NewAttribute("flag", data.TypeBool, nil),
+	}}
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	valid := map[string]data.Type{
+		`$.count + 1`:                          data.TypeInt,
+		`$.count * $.price`:                    data.TypeFloat64,
+		`$.name + $.count`:                     data.TypeString,
+		`$.count > 1 && $.flag`:                data.TypeBool,
+		`!$.flag`:                              data.TypeBool,
+		`$.flag ? "a" : "b"`:                   data.TypeString,
+		`$.order.id`:                           data.TypeAny,
+		`$.missing?.name ?? "none"`:            data.TypeAny,
+		`$.optional ?? "default"`:              data.TypeAny,
+		`$.optional.name ?? $.name`:            data.TypeAny,
+		`$.name ?? "default"`:                  data.TypeString,
+		`isdefined($.missing)`:                 data.TypeBool,
+		`string.concat($.name, "-", $.count)`:  data.TypeAny,
+		`array.map($.items, x => x.price * 2)`: data.TypeAny,
+		`number.round($.price, 2)`:             data.TypeAny,
+		`$env[HOME] + "/dir"`:                  data.TypeString,
+	}
+	for exprStr, expected := range valid {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		typ, errs := expression.Check(expr, env)
+		assert.Empty(t, errs, exprStr)
+		assert.Equal(t, expected, typ, exprStr)
+	}
+
+	invalid := map[string]string{
+		`$.cuont + 1`:                  "unknown reference '$.cuont'",
+		`$.order * 2`:                  "cannot multiply object and int",
+		`$.items + 1`:                  "cannot add int to array",
+		`-$.flag`:                      "cannot negate bool",
+		`!$.name`:                      "cannot not string",
+		`$.count.value`:                "cannot access '.value' of '$.count', it is of type int",
+		`number.round($.price)`:        "round function should have 2 arguments, got 1",
+		`number.abs($.order)`:          "argument 1 of abs function cannot be coerced from object to float64",
+		`string.concat($.name, $.nop)`: "unknown reference '$.nop'",
+		`$.optional ?? $.nop`:          "unknown reference '$.nop'",
+		`$.count.value ?? 1`:           "cannot access '.value' of '$.count', it is of type int",
+	}
+	for exprStr, expected := range invalid {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		_, errs := expression.Check(expr, env)
+		if assert.Len(t, errs, 1, exprStr) {
+			assert.EqualError(t, errs[0], expected, exprStr)
+		}
+	}
+
+	// without scope metadata scope references are not checked
+	expr, err := factory.NewExpr(`$.cuont + 1`)
+	assert.Nil(t, err)
+	_, errs := expression.Check(expr, &expression.CheckEnv{})
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,75 @@
+package script
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayAndObjectLiterals(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"name": "a", "price": 5},
+		map[string]interface{}{"name": "b", "price": 20},
+	}
+	scope := data.NewSimpleScope(map[string]interface{}{"items": items, "x": 1, "name": "abc", "status": "open"}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := map[string]interface{}{
+		`[]`:                          []interface{}{},
+		`{}`:                          map[string]interface{}{},
+		`[$.x, $.x + 1, "c"]`:         []interface{}{1, 2, "c"},
+		`[[$.x], []]`:                 []interface{}{[]interface{}{1}, []interface{}{}},
+		`{"a": $.x, b: $.name}`:       map[string]interface{}{"a": 1, "b": "abc"},
+		`{'a b': {"c": [$.x]}}`:       map[string]interface{}{"a b": map[string]interface{}{"c": []interface{}{1}}},
+		`[$.x > 0 ? "pos" : "neg"]`:   []interface{}{"pos"},
+		`{"v": $.x > 0 ? 1 : 2}`:      map[string]interface{}{"v": 1},
+		`$.x > 0 ? [1] : [2]`:         []interface{}{1},
+		`$.status in ["open", "new"]`: true,
+		`[$.x, 2] contains 3`:         false,
+		`builtin.len([$.x, 2])`:       2,
+		`array.map($.items, x => {"label": x.name, "expensive": x.price > 10})`: []interface{}{
+			map[string]interface{}{"label": "a", "expensive": false},
+			map[string]interface{}{"label": "b", "expensive": true},
+		},
+		`array.map($.items, x => [x.name])`: []interface{}{[]interface{}{"a"}, []interface{}{"b"}},
+	}
+
+	for exprStr, expected := range testcases {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		if err != nil {
+			continue
+		}
+		v, err := expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, expected, v, exprStr)
+	}
+
+	// each evaluation creates a new value
+	expr, err := factory.NewExpr(`[$.x]`)
+	assert.Nil(t, err)
+	v1, _ := expr.Eval(scope)
+	v1.([]interface{})[0] = 5
+	v2, _ := expr.Eval(scope)
+	assert.Equal(t, []interface{}{1}, v2)
+
+	_, err = factory.NewExpr(`{"a": 1, "a": $.x}`)
+	assert.NotNil(t, err)
+	_, err = factory.NewExpr(`[$.x,]`)
+	assert.NotNil(t, err)
+	// ?[ is the safe index operator, a space is required before an array literal in a ternary
+	_, err = factory.NewExpr(`$.x > 0 ?[1] : [2]`)
+	assert.NotNil(t, err)
+
+	expr, err = factory.NewExpr(`{"a": $.x, "b": [$.name]}`)
+	assert.Nil(t, err)
+	_, ex, err := expression.Explain(expr, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "object", ex.Type)
+	assert.Len(t, ex.Children, 2)
+	assert.Equal(t, "b", ex.Children[1].Name)
+	assert.Equal(t, "array", ex.Children[1].Children[0].Type)
+}
@@ -0,0 +1,72 @@
+package script
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"a": 5, "b": "x", "c": nil}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	expr, err := factory.NewExpr(`$.a > 10 && $.b == "x"`)
+	assert.Nil(t, err)
+	val, ex, err := expression.Explain(expr, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, false, val)
+	assert.Equal(t, "and", ex.Name)
+	// the right operand is not evaluated
+	assert.Len(t, ex.Children, 1)
+	assert.Equal(t, "greater than", ex.Children[0].Name)
+	assert.Equal(t, "5 > 10", ex.Children[0].Evaluation)
+	assert.Equal(t, "operator and => false\n  operator greater than [5 > 10] => false\n    reference $.a => 5\n    literal => 10", ex.String())
+
+	// explaining gives the same result as evaluating
+	v, err := expr.Eval(scope)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	expr, err = factory.NewExpr(`$.a < 10 ? script.concat($.b, "y") : "z"`)
+	assert.Nil(t, err)
+	val, ex, err = expression.Explain(expr, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "xy", val)
+	assert.Equal(t, "ternary", ex.Type)
+	assert.Len(t, ex.Children, 2)
+	assert.Equal(t, "function", ex.Children[1].Type)
+	assert.Equal(t, "concat", ex.Children[1].Name)
+	assert.Len(t, ex.Children[1].Children, 2)
+
+	expr, err = factory.NewExpr(`$.c ?? $.a * 2`)
+	assert.Nil(t, err)
+	val, ex, err = expression.Explain(expr, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, val)
+	assert.Equal(t, "coalesce", ex.Name)
+	assert.Equal(t, "5 * 2", ex.Children[1].Evaluation)
+
+	expr, err = factory.NewExpr(`!($.a == 5)`)
+	assert.Nil(t, err)
+	val, ex, err = expression.Explain(expr, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, false, val)
+	assert.Equal(t, "not", ex.Name)
+}
+
+func TestExplainError(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"a": 5}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	expr, err := factory.NewExpr(`$.a == 5 && $.missing.name == "x"`)
+	assert.Nil(t, err)
+	_, ex, err := expression.Explain(expr, scope)
+	assert.NotNil(t, err)
+	assert.Equal(t, err.Error(), ex.Error)
+	assert.Len(t, ex.Children, 2)
+	assert.NotEmpty(t, ex.Children[1].Error)
+	assert.Contains(t, ex.String(), "=> error: ")
+}
@@ -2,6 +2,7 @@ package script
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/project-flogo/core/data/expression"
 	"github.com/project-flogo/core/data/expression/script/gocc/ast"
@@ -10,7 +11,15 @@ import (
 	"github.com/project-flogo/core/data/expression/script/gocc/parser"
 	"github.com/project-flogo/core/data/resolve"
 
+	_ "github.com/project-flogo/core/data/expression/function/array"
+	_ "github.com/project-flogo/core/data/expression/function/base64"
 	_ "github.com/project-flogo/core/data/expression/function/builtin"
+	_ "github.com/project-flogo/core/data/expression/function/datetime"
+	_ "github.com/project-flogo/core/data/expression/function/hash"
+	_ "github.com/project-flogo/core/data/expression/function/json"
+	_ "github.com/project-flogo/core/data/expression/function/number"
+	_ "github.com/project-flogo/core/data/expression/function/string"
+	_ "github.com/project-flogo/core/data/expression/function/url"
 )
 
 func init() {
@@ -18,14 +27,39 @@ func init() {
 }
 
 func NewExprFactory(resolver resolve.CompositeResolver) expression.Factory {
-	return &factoryImpl{resolver: resolver}
+	// the resolver is part of the cache key, so it has to be comparable
+	cacheable := resolver != nil && reflect.TypeOf(resolver).Comparable()
+	return &factoryImpl{resolver: resolver, cacheable: cacheable}
 }
 
 type factoryImpl struct {
-	resolver resolve.CompositeResolver
+	resolver  resolve.CompositeResolver
+	cacheable bool
 }
 
 func (f *factoryImpl) NewExpr(exprStr string) (expression.Expr, error) {
+	if !f.cacheable {
+		return f.compile(exprStr, f.resolver)
+	}
+
+	key := cacheKey{resolver: f.resolver, expr: exprStr}
+	if expr, ok := compiled.get(key); ok {
+		return expr, nil
+	}
+
+	tracker := &staticTracker{CompositeResolver: f.resolver}
+	expr, err := f.compile(exprStr, tracker)
+	if err != nil {
+		return nil, err
+	}
+	// the detail of an evaluation is only meaningful for an expression that isn't shared
+	if _, detailed := expr.(ast.ExprEvalResult); !detailed && !tracker.isStatic() {
+		compiled.put(key, expr)
+	}
+	return expr, nil
+}
+
+func (f *factoryImpl) compile(exprStr string, resolver resolve.CompositeResolver) (expression.Expr, error) {
 	st, err := parse(exprStr)
 	if err != nil {
 		if gerr, ok := err.(*errors.Error); ok {
@@ -42,7 +76,7 @@ func (f *factoryImpl) NewExpr(exprStr string) (expression.Expr, error) {
 
 	expr, ok := st.(ast.Expr)
 	if ok {
-		err := expr.Init(f.resolver, true)
+		err := expr.Init(resolver, true)
 		if err != nil {
 			return nil, err
 		}
@@ -128,3 +128,32 @@ func (tLength) Eval(params ...interface{}) (interface{}, error) {
 	p := params[0].(string)
 	return len(p), nil
 }
+
+func TestStandardFuncExpr(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"order": map[string]interface{}{"id": "a-1", "tags": []interface{}{"x", "y"}, "price": 12.345}}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := make(map[string]interface{})
+	testcases[`string.toUpper($.order.id)`] = "A-1"
+	testcases[`string.concat(string.substring($.order.id, 0, 1), "-", number.round($.order.price, 1))`] = "a-12.3"
+	testcases[`array.contains($.order.tags, "y")`] = true
+	testcases[`string.join(array.reverse($.order.tags), ",")`] = "y,x"
+	testcases[`datetime.format(datetime.add("2021-01-01T00:00:00Z", "36h"), "Date")`] = "2021-01-02"
+	testcases[`base64.decode(base64.encode("flogo"))`] = "flogo"
+	testcases[`json.stringify($.order.tags)`] = `["x","y"]`
+
+	for k, v := range testcases {
+		vv, err := factory.NewExpr(k)
+		assert.Nil(t, err, k)
+		result, err := vv.Eval(scope)
+		assert.Nil(t, err, k)
+		if !assert.ObjectsAreEqual(v, result) {
+			assert.Fail(t, fmt.Sprintf("test function [%s] failed, expected [%+v] but actual [%+v]", k, v, result))
+		}
+	}
+
+	expr, err := factory.NewExpr(`datetime.now()`)
+	assert.Nil(t, err)
+	_, err = expr.Eval(scope)
+	assert.Nil(t, err)
+}
@@ -57,6 +57,10 @@ func (e *arithAddExpr) Eval(scope data.Scope) (interface{}, error) {
 		return nil, fmt.Errorf("cannot add %v to %v", lv, rv)
 	}
 
+	if result, ok := fastArith('+', lv, rv); ok {
+		return result, nil
+	}
+
 	rt := reflect.TypeOf(rv).Kind()
 	switch le := lv.(type) {
 	case int, int32, int64:
@@ -137,6 +141,10 @@ func (e *arithSubExpr) Eval(scope data.Scope) (interface{}, error) {
 		return nil, fmt.Errorf("cannot subtract %v from %v", rightValue, leftValue)
 	}
 
+	if result, ok := fastArith('-', leftValue, rightValue); ok {
+		return result, nil
+	}
+
 	rt := reflect.TypeOf(rightValue).Kind()
 	switch le := leftValue.(type) {
 	case int, int32, int64:
@@ -208,6 +216,10 @@ func (e *arithMulExpr) Eval(scope data.Scope) (interface{}, error) {
 		return nil, fmt.Errorf("cannot multiply %v with %v", rv, lv)
 	}
 
+	if result, ok := fastArith('*', lv, rv); ok {
+		return result, nil
+	}
+
 	rt := reflect.TypeOf(rv).Kind()
 	switch le := lv.(type) {
 	case int, int32, int64:
@@ -277,6 +289,10 @@ func (e *arithDivExpr) Eval(scope data.Scope) (interface{}, error) {
 		return nil, fmt.Errorf("cannot div %v with %v", lv, rv)
 	}
 
+	if result, ok := fastArith('/', lv, rv); ok {
+		return result, nil
+	}
+
 	rt := reflect.TypeOf(rv).Kind()
 	switch le := lv.(type) {
 	case int, int32, int64:
@@ -348,6 +364,10 @@ func (e *arithModExpr) Eval(scope data.Scope) (interface{}, error) {
 		return nil, fmt.Errorf("cannot mod %v with %v", rv, lv)
 	}
 
+	if result, ok := fastArith('%', lv, rv); ok {
+		return result, nil
+	}
+
 	rt := reflect.TypeOf(rv).Kind()
 	switch le := lv.(type) {
 	case int, int32, int64:
@@ -7,6 +7,9 @@ import (
 )
 
 // Those errors are indicate that resolver not able to found the attr or fields
+//
+// Deprecated: resolvers should return a resolve.NotFoundError, the messages are only matched for resolvers
+// that still return untyped errors
 var errorStrs = []string{
 	"path not found",
 	"unable to evaluate path",
@@ -59,8 +62,7 @@ func (d *GetValueExpr) Eval(scope data.Scope) (interface{}, error) {
 func isDefined(expr Expr, scope data.Scope) (interface{}, bool, error) {
 	v, err := expr.Eval(scope)
 	if err != nil {
-		msg := err.Error()
-		if isNotFoundError(msg) {
+		if resolve.IsNotFound(err) || isNotFoundError(err.Error()) {
 			return nil, false, nil
 		}
 		return nil, false, err
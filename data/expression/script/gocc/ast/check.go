@@ -0,0 +1,253 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+// check infers the type of a sub-expression, expressions that cannot be checked are of type any
+func check(e Expr, env *expression.CheckEnv) (data.Type, []error) {
+	return expression.Check(e, env)
+}
+
+func checkLR(left, right Expr, env *expression.CheckEnv) (lt, rt data.Type, errs []error) {
+	lt, lErrs := check(left, env)
+	rt, rErrs := check(right, env)
+	return lt, rt, append(lErrs, rErrs...)
+}
+
+// isNonArithType returns whether values of the type can never be operands of an arithmetic operator
+func isNonArithType(t data.Type) bool {
+	switch t {
+	case data.TypeBool, data.TypeObject, data.TypeArray, data.TypeMap, data.TypeParams, data.TypeDateTime, data.TypeBytes:
+		return true
+	}
+	return false
+}
+
+// arithType is the type of the result of an arithmetic operator applied to operands of the specified types
+func arithType(lt, rt data.Type) data.Type {
+	if !expression.IsNumericType(lt) || !expression.IsNumericType(rt) {
+		return data.TypeAny
+	}
+	if lt == data.TypeFloat32 || lt == data.TypeFloat64 || rt == data.TypeFloat32 || rt == data.TypeFloat64 {
+		return data.TypeFloat64
+	}
+	return data.TypeInt
+}
+
+func (e *arithAddExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	lt, rt, errs := checkLR(e.left, e.right, env)
+	if lt == data.TypeString || rt == data.TypeString {
+		return data.TypeString, errs
+	}
+	if isNonArithType(lt) || isNonArithType(rt) {
+		return data.TypeAny, append(errs, fmt.Errorf("cannot add %s to %s", rt, lt))
+	}
+	return arithType(lt, rt), errs
+}
+
+func checkArith(op string, left, right Expr, env *expression.CheckEnv) (data.Type, []error) {
+	lt, rt, errs := checkLR(left, right, env)
+	if isNonArithType(lt) || isNonArithType(rt) {
+		return data.TypeAny, append(errs, fmt.Errorf("cannot %s %s and %s", op, lt, rt))
+	}
+	return arithType(lt, rt), errs
+}
+
+func (e *arithSubExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkArith("subtract", e.left, e.right, env)
+}
+
+func (e *arithMulExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkArith("multiply", e.left, e.right, env)
+}
+
+func (e *arithDivExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	t, errs := checkArith("divide", e.left, e.right, env)
+	if expression.IsNumericType(t) {
+		// division of integers can result in a float
+		return data.TypeAny, errs
+	}
+	return t, errs
+}
+
+func (e *arithModExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkArith("mod", e.left, e.right, env)
+}
+
+func checkBool(left, right Expr, env *expression.CheckEnv) (data.Type, []error) {
+	_, _, errs := checkLR(left, right, env)
+	return data.TypeBool, errs
+}
+
+func (e *cmpEqExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *cmpNotEqExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *cmpGtExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *cmpGtEqExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *cmpLtExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *cmpLtEqExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *boolOrExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *boolAndExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *unaryNotExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	t, errs := check(e.expr, env)
+	if expression.IsKnownType(t) && t != data.TypeBool {
+		errs = append(errs, fmt.Errorf("cannot not %s", t))
+	}
+	return data.TypeBool, errs
+}
+
+func (e *unaryNegExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	t, errs := check(e.expr, env)
+	if isNonArithType(t) {
+		return data.TypeAny, append(errs, fmt.Errorf("cannot negate %s", t))
+	}
+	return t, errs
+}
+
+func (e *exprTernary) Check(env *expression.CheckEnv) (data.Type, []error) {
+	_, errs := check(e.ifExpr, env)
+	tt, tErrs := check(e.thenExpr, env)
+	et, eErrs := check(e.elseExpr, env)
+	errs = append(append(errs, tErrs...), eErrs...)
+	if tt == et {
+		return tt, errs
+	}
+	return data.TypeAny, errs
+}
+
+func (e *coalesceExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	lt, lErrs := check(e.left, env)
+	rt, rErrs := check(e.right, env)
+	// the left operand is allowed to be missing
+	var errs []error
+	for _, err := range lErrs {
+		if !resolve.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, rErrs...)
+	if lt == rt {
+		return lt, errs
+	}
+	return data.TypeAny, errs
+}
+
+func (e *exprRef) Check(env *expression.CheckEnv) (data.Type, []error) {
+	var errs []error
+	for _, v := range e.fields {
+		if ie, ok := v.(Expr); ok {
+			_, iErrs := check(ie, env)
+			errs = append(errs, iErrs...)
+		}
+	}
+	if e.hasIndexExpr {
+		return data.TypeAny, errs
+	}
+
+	t := make([]string, len(e.fields))
+	for i, v := range e.fields {
+		t[i], _ = v.(string)
+	}
+	ref := strings.Join(t, "")
+
+	typ, err := expression.CheckRef(ref, env)
+	if len(e.safeFields) > 0 {
+		// missing values are expected when using safe navigation
+		return data.TypeAny, errs
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return typ, errs
+}
+
+func (e *keyIndexExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	_, errs := check(e.expr, env)
+	return data.TypeString, errs
+}
+
+func (e *funcExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	var errs []error
+
+	paramTypes, isVariadic := e.f.Sig()
+	if !isVariadic && len(e.params) != len(paramTypes) {
+		errs = append(errs, fmt.Errorf("%s function should have %d arguments, got %d", e.f.Name(), len(paramTypes), len(e.params)))
+	}
+
+	for idx, param := range e.params {
+		t, pErrs := check(param, env)
+		errs = append(errs, pErrs...)
+
+		typeIdx := idx
+		if isVariadic {
+			typeIdx = 0
+		}
+		if typeIdx < len(paramTypes) && !expression.CanCoerce(t, paramTypes[typeIdx]) {
+			errs = append(errs, fmt.Errorf("argument %d of %s function cannot be coerced from %s to %s", idx+1, e.f.Name(), t, paramTypes[typeIdx]))
+		}
+	}
+
+	return data.TypeAny, errs
+}
+
+func (e *literalExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	switch e.typ {
+	case "int":
+		return data.TypeInt, nil
+	case "float":
+		return data.TypeFloat64, nil
+	case "bool":
+		return data.TypeBool, nil
+	case "string":
+		return data.TypeString, nil
+	}
+	return data.TypeAny, nil
+}
+
+func (d *IsDefinedExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	// the reference is allowed to be missing
+	return data.TypeBool, nil
+}
+
+func (d *GetValueExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	_, errs := check(d.valueExpr, env)
+	return data.TypeAny, errs
+}
+
+func (e *lambdaExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	_, errs := check(e.body, env)
+	return data.TypeAny, errs
+}
+
+func (e *identExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return data.TypeAny, nil
+}
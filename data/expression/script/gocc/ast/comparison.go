@@ -32,15 +32,20 @@ func NewCmpExpr(left, operand, right interface{}) (Expr, error) {
 		return &cmpGtEqExpr{left: le, right: re}, nil
 	}
 
+	if mop, ok := matchOps[op]; ok {
+		return &matchExpr{op: mop, left: le, right: re}, nil
+	}
+
 	return nil, fmt.Errorf("unsupported cmpative operator '%s'", op)
 }
 
 type cmpEqExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpEqExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -60,12 +65,18 @@ func (e *cmpEqExpr) Eval(scope data.Scope) (interface{}, error) {
 		return rv == nil && lv == nil, nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "equal to",
-		ExpressionEvaluation: leftValue + " == " + rightValue,
+	e.last.record(lv, rv)
+
+	return isEqual(lv, rv), nil
+}
+
+// isEqual compares values using the rules of the == operator
+func isEqual(lv, rv interface{}) bool {
+	if lv == nil || rv == nil {
+		return rv == nil && lv == nil
+	}
+	if result, ok := fastCompare(opEq, lv, rv); ok {
+		return result
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -74,72 +85,73 @@ func (e *cmpEqExpr) Eval(scope data.Scope) (interface{}, error) {
 		if rt == reflect.Int || rt == reflect.Int32 || rt == reflect.Int64 {
 			li, _ := coerce.ToInt64(lv)
 			ri, _ := coerce.ToInt64(rv)
-			return li == ri, nil
+			return li == ri
 		}
 		if rt == reflect.Float32 || rt == reflect.Float64 {
 			li, _ := coerce.ToFloat64(lv)
 			ri, _ := coerce.ToFloat64(rv)
-			return li == ri, nil
+			return li == ri
 		}
 
 		if isJsonNumber(rv) {
 			li, _ := coerce.ToInt64(lv)
 			ri, _ := coerce.ToInt64(rv)
-			return li == ri, nil
+			return li == ri
 		}
 
 	case float32, float64:
 		if rt == reflect.Int || rt == reflect.Int32 || rt == reflect.Int64 || rt == reflect.Float32 || rt == reflect.Float64 || isJsonNumber(rv) {
 			lf, _ := coerce.ToFloat64(lv)
 			rf, _ := coerce.ToFloat64(rv)
-			return lf == rf, nil
+			return lf == rf
 		}
 	case json.Number:
 		if strings.Contains(le.String(), ".") {
 			li, _ := le.Float64()
 			ri, _ := coerce.ToFloat64(rv)
-			return li == ri, nil
+			return li == ri
 		} else {
 			li, _ := le.Int64()
 			ri, _ := coerce.ToInt64(rv)
-			return li == ri, nil
+			return li == ri
 		}
 	case string:
 		if rt == reflect.String {
 			rs, _ := coerce.ToString(rv)
-			return le == rs, nil
+			return le == rs
 		}
 	case bool:
 		if rt == reflect.Bool {
 			rb, _ := coerce.ToBool(rv)
-			return le == rb, nil
+			return le == rb
 		}
 	case time.Time:
 		switch re := rv.(type) {
 		case time.Time:
-			return le.Equal(re), nil
+			return le.Equal(re)
 		default:
 			t, err := coerce.ToDateTime(rv)
 			if err != nil {
-				return false, nil
+				return false
 			}
-			return le.Equal(t), nil
+			return le.Equal(t)
 		}
 	}
 
-	return false, nil
+	return false
 }
 
 func (e *cmpEqExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("equal to", "==")
 }
 
 type cmpNotEqExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpNotEqExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -159,12 +171,10 @@ func (e *cmpNotEqExpr) Eval(scope data.Scope) (interface{}, error) {
 		return !(rv == nil && lv == nil), nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "not equal to",
-		ExpressionEvaluation: leftValue + " != " + rightValue,
+	e.last.record(lv, rv)
+
+	if result, ok := fastCompare(opNotEq, lv, rv); ok {
+		return result, nil
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -229,15 +239,16 @@ func (e *cmpNotEqExpr) Eval(scope data.Scope) (interface{}, error) {
 }
 
 func (e *cmpNotEqExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("not equal to", "!=")
 }
 
 type cmpGtExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpGtExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -257,12 +268,10 @@ func (e *cmpGtExpr) Eval(scope data.Scope) (interface{}, error) {
 		return false, nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "greater than",
-		ExpressionEvaluation: leftValue + " > " + rightValue,
+	e.last.record(lv, rv)
+
+	if result, ok := fastCompare(opGt, lv, rv); ok {
+		return result, nil
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -322,15 +331,16 @@ func (e *cmpGtExpr) Eval(scope data.Scope) (interface{}, error) {
 }
 
 func (e *cmpGtExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("greater than", ">")
 }
 
 type cmpGtEqExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpGtEqExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -349,12 +359,10 @@ func (e *cmpGtEqExpr) Eval(scope data.Scope) (interface{}, error) {
 		return lv == nil && rv == nil, nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "greater than equal to",
-		ExpressionEvaluation: leftValue + " >= " + rightValue,
+	e.last.record(lv, rv)
+
+	if result, ok := fastCompare(opGtEq, lv, rv); ok {
+		return result, nil
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -418,15 +426,16 @@ func (e *cmpGtEqExpr) Eval(scope data.Scope) (interface{}, error) {
 }
 
 func (e *cmpGtEqExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("greater than equal to", ">=")
 }
 
 type cmpLtExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpLtExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -446,12 +455,10 @@ func (e *cmpLtExpr) Eval(scope data.Scope) (interface{}, error) {
 		return false, nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "less than",
-		ExpressionEvaluation: leftValue + " < " + rightValue,
+	e.last.record(lv, rv)
+
+	if result, ok := fastCompare(opLt, lv, rv); ok {
+		return result, nil
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -513,15 +520,16 @@ func (e *cmpLtExpr) Eval(scope data.Scope) (interface{}, error) {
 }
 
 func (e *cmpLtExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("less than", "<")
 }
 
 type cmpLtEqExpr struct {
 	left, right Expr
-	evalResult  ExprEvalData
+	last        *lastEval
 }
 
 func (e *cmpLtEqExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	err := e.left.Init(resolver, false)
 	if err != nil {
 		return err
@@ -540,12 +548,10 @@ func (e *cmpLtEqExpr) Eval(scope data.Scope) (interface{}, error) {
 		return lv == nil && rv == nil, nil
 	}
 
-	leftValue, _ := coerce.ToString(lv)
-	rightValue, _ := coerce.ToString(rv)
-	e.evalResult = ExprEvalData{
-		ExpressionType:       "operator",
-		ExpressionName:       "less than equal to",
-		ExpressionEvaluation: leftValue + " <= " + rightValue,
+	e.last.record(lv, rv)
+
+	if result, ok := fastCompare(opLtEq, lv, rv); ok {
+		return result, nil
 	}
 
 	rt := reflect.TypeOf(rv).Kind()
@@ -608,5 +614,5 @@ func (e *cmpLtEqExpr) Eval(scope data.Scope) (interface{}, error) {
 }
 
 func (e *cmpLtEqExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.operatorDetail("less than equal to", "<=")
 }
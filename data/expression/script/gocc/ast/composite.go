@@ -0,0 +1,173 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/expression/script/gocc/token"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+func NewArrayExpr(elems interface{}) (Expr, error) {
+	if elems == nil {
+		return &arrayExpr{}, nil
+	}
+	lst, ok := elems.([]Expr)
+	if !ok {
+		return nil, fmt.Errorf("invalid array elements type; expected []ast.Expr, got %T", elems)
+	}
+	return &arrayExpr{elems: lst}, nil
+}
+
+type objectField struct {
+	key   string
+	value Expr
+}
+
+func NewField(key, value interface{}) (*objectField, error) {
+	valueExpr, ok := value.(Expr)
+	if !ok {
+		return nil, fmt.Errorf("invalid object field value type; expected ast.Expr, got %T", value)
+	}
+
+	name := strings.TrimSpace(string(key.(*token.Token).Lit))
+	if strings.HasPrefix(name, "\"") || strings.HasPrefix(name, "'") || strings.HasPrefix(name, "`") {
+		name = removeQuotedAndEscaped(name)
+	}
+	return &objectField{key: name, value: valueExpr}, nil
+}
+
+func NewFieldList(field interface{}) ([]*objectField, error) {
+	return AppendToFieldList([]*objectField(nil), field)
+}
+
+func AppendToFieldList(list, field interface{}) ([]*objectField, error) {
+	fields, ok := list.([]*objectField)
+	if !ok {
+		return nil, fmt.Errorf("invalid object field list type; expected []*ast.objectField, got %T", list)
+	}
+	f, ok := field.(*objectField)
+	if !ok {
+		return nil, fmt.Errorf("invalid object field type; expected *ast.objectField, got %T", field)
+	}
+
+	for _, existing := range fields {
+		if existing.key == f.key {
+			return nil, fmt.Errorf("duplicate key '%s' in object literal", f.key)
+		}
+	}
+	return append(fields, f), nil
+}
+
+func NewObjectExpr(fields interface{}) (Expr, error) {
+	if fields == nil {
+		return &objectExpr{}, nil
+	}
+	lst, ok := fields.([]*objectField)
+	if !ok {
+		return nil, fmt.Errorf("invalid object fields type; expected []*ast.objectField, got %T", fields)
+	}
+	return &objectExpr{fields: lst}, nil
+}
+
+// arrayExpr is an array literal, a new array is created each time it is evaluated
+type arrayExpr struct {
+	elems []Expr
+}
+
+func (e *arrayExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	for _, elem := range e.elems {
+		if err := elem.Init(resolver, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *arrayExpr) Eval(scope data.Scope) (interface{}, error) {
+	arr := make([]interface{}, len(e.elems))
+	for i, elem := range e.elems {
+		v, err := elem.Eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		arr[i] = v
+	}
+	return arr, nil
+}
+
+func (e *arrayExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	var errs []error
+	for _, elem := range e.elems {
+		_, elemErrs := check(elem, env)
+		errs = append(errs, elemErrs...)
+	}
+	return data.TypeArray, errs
+}
+
+func (e *arrayExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "array"}
+	arr := make([]interface{}, len(e.elems))
+	for i, elem := range e.elems {
+		v, ee, err := explain(elem, scope)
+		ex.Children = append(ex.Children, ee)
+		if err != nil {
+			return explainFailed(ex, err)
+		}
+		arr[i] = v
+	}
+	ex.SetResult(arr, nil)
+	return arr, ex, nil
+}
+
+// objectExpr is an object literal, a new object is created each time it is evaluated
+type objectExpr struct {
+	fields []*objectField
+}
+
+func (e *objectExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	for _, f := range e.fields {
+		if err := f.value.Init(resolver, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *objectExpr) Eval(scope data.Scope) (interface{}, error) {
+	obj := make(map[string]interface{}, len(e.fields))
+	for _, f := range e.fields {
+		v, err := f.value.Eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		obj[f.key] = v
+	}
+	return obj, nil
+}
+
+func (e *objectExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	var errs []error
+	for _, f := range e.fields {
+		_, fieldErrs := check(f.value, env)
+		errs = append(errs, fieldErrs...)
+	}
+	return data.TypeObject, errs
+}
+
+func (e *objectExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "object"}
+	obj := make(map[string]interface{}, len(e.fields))
+	for _, f := range e.fields {
+		v, fe, err := explain(f.value, scope)
+		ex.Children = append(ex.Children, &expression.Explanation{Type: "field", Name: f.key, Value: v, Error: fe.Error, Children: []*expression.Explanation{fe}})
+		if err != nil {
+			return explainFailed(ex, err)
+		}
+		obj[f.key] = v
+	}
+	ex.SetResult(obj, nil)
+	return obj, ex, nil
+}
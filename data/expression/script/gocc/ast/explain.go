@@ -0,0 +1,274 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+// The explanations below evaluate each sub-expression once and apply the operator to the results, the
+// nodes are not modified so expressions can be explained while they are being evaluated concurrently
+
+func explain(e Expr, scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return expression.Explain(e, scope)
+}
+
+func explainFailed(ex *expression.Explanation, err error) (interface{}, *expression.Explanation, error) {
+	ex.SetResult(nil, err)
+	return nil, ex, err
+}
+
+// explainOperator explains a binary operator, op creates the operator for the evaluated operands
+func explainOperator(name, symbol string, left, right Expr, scope data.Scope, op func(left, right Expr) Expr) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "operator", Name: name}
+
+	lv, le, err := explain(left, scope)
+	ex.Children = append(ex.Children, le)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	rv, re, err := explain(right, scope)
+	ex.Children = append(ex.Children, re)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+
+	ex.Evaluation = formatOperands(lv, symbol, rv)
+	val, err := op(&literalExpr{val: lv}, &literalExpr{val: rv}).Eval(nil)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
+func (e *arithAddExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("add", "+", e.left, e.right, scope, func(l, r Expr) Expr { return &arithAddExpr{left: l, right: r} })
+}
+
+func (e *arithSubExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("subtract", "-", e.left, e.right, scope, func(l, r Expr) Expr { return &arithSubExpr{left: l, right: r} })
+}
+
+func (e *arithMulExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("multiply", "*", e.left, e.right, scope, func(l, r Expr) Expr { return &arithMulExpr{left: l, right: r} })
+}
+
+func (e *arithDivExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("divide", "/", e.left, e.right, scope, func(l, r Expr) Expr { return &arithDivExpr{left: l, right: r} })
+}
+
+func (e *arithModExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("mod", "%", e.left, e.right, scope, func(l, r Expr) Expr { return &arithModExpr{left: l, right: r} })
+}
+
+func (e *cmpEqExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("equal to", "==", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpEqExpr{left: l, right: r} })
+}
+
+func (e *cmpNotEqExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("not equal to", "!=", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpNotEqExpr{left: l, right: r} })
+}
+
+func (e *cmpGtExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("greater than", ">", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpGtExpr{left: l, right: r} })
+}
+
+func (e *cmpGtEqExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("greater than equal to", ">=", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpGtEqExpr{left: l, right: r} })
+}
+
+func (e *cmpLtExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("less than", "<", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpLtExpr{left: l, right: r} })
+}
+
+func (e *cmpLtEqExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainOperator("less than equal to", "<=", e.left, e.right, scope, func(l, r Expr) Expr { return &cmpLtEqExpr{left: l, right: r} })
+}
+
+// explainLogical explains a logical operator, the right operand is only evaluated if the left operand does
+// not determine the result
+func explainLogical(name string, isAnd bool, left, right Expr, scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "operator", Name: name}
+
+	lv, le, err := explain(left, scope)
+	ex.Children = append(ex.Children, le)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	lb, err := coerce.ToBool(lv)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	if lb != isAnd {
+		ex.SetResult(lb, nil)
+		return lb, ex, nil
+	}
+
+	rv, re, err := explain(right, scope)
+	ex.Children = append(ex.Children, re)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	rb, err := coerce.ToBool(rv)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	ex.SetResult(rb, nil)
+	return rb, ex, nil
+}
+
+func (e *boolOrExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainLogical("or", false, e.left, e.right, scope)
+}
+
+func (e *boolAndExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainLogical("and", true, e.left, e.right, scope)
+}
+
+func explainUnary(name, symbol string, operand Expr, scope data.Scope, op func(operand Expr) Expr) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "operator", Name: name}
+
+	v, oe, err := explain(operand, scope)
+	ex.Children = append(ex.Children, oe)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+
+	vs, _ := coerce.ToString(v)
+	ex.Evaluation = symbol + vs
+	val, err := op(&literalExpr{val: v}).Eval(nil)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
+func (e *unaryNotExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainUnary("not", "!", e.expr, scope, func(o Expr) Expr { return &unaryNotExpr{expr: o} })
+}
+
+func (e *unaryNegExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return explainUnary("negate", "-", e.expr, scope, func(o Expr) Expr { return &unaryNegExpr{expr: o} })
+}
+
+func (e *exprTernary) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "ternary"}
+
+	iv, ie, err := explain(e.ifExpr, scope)
+	ex.Children = append(ex.Children, ie)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+	bv, err := coerce.ToBool(iv)
+	if err != nil {
+		return explainFailed(ex, err)
+	}
+
+	branch := e.elseExpr
+	if bv {
+		branch = e.thenExpr
+	}
+	val, be, err := explain(branch, scope)
+	ex.Children = append(ex.Children, be)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
+func (e *coalesceExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "operator", Name: "coalesce"}
+
+	lv, le, err := explain(e.left, scope)
+	ex.Children = append(ex.Children, le)
+	if err != nil && !resolve.IsNotFound(err) {
+		return explainFailed(ex, err)
+	}
+	if err == nil && lv != nil {
+		ex.SetResult(lv, nil)
+		return lv, ex, nil
+	}
+
+	rv, re, err := explain(e.right, scope)
+	ex.Children = append(ex.Children, re)
+	ex.SetResult(rv, err)
+	return rv, ex, err
+}
+
+func (e *funcExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "function", Name: e.f.Name()}
+
+	vals := make([]interface{}, len(e.params))
+	for idx, param := range e.params {
+		v, pe, err := explain(param, scope)
+		ex.Children = append(ex.Children, pe)
+		if err != nil {
+			return explainFailed(ex, err)
+		}
+		vals[idx] = v
+	}
+
+	val, err := function.Eval(e.f, vals...)
+	ex.Evaluation, _ = coerce.ToString(val)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
+func (e *exprRef) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "reference", Name: refText(e.fields) + refText(e.safeFields)}
+
+	for _, v := range e.fields {
+		if ie, ok := v.(*keyIndexExpr); ok {
+			_, ce, _ := explain(ie.expr, scope)
+			ex.Children = append(ex.Children, ce)
+		}
+	}
+
+	val, err := e.Eval(scope)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
+// refText is the text of a reference, index expressions are shown as [...]
+func refText(fields []interface{}) string {
+	var b strings.Builder
+	for _, v := range fields {
+		switch t := v.(type) {
+		case string:
+			b.WriteString(t)
+		case *safeNav:
+			if t.index != nil {
+				b.WriteString("?[...]")
+			} else {
+				b.WriteString("?.")
+				b.WriteString(t.name)
+			}
+		default:
+			b.WriteString("[...]")
+		}
+	}
+	return b.String()
+}
+
+func (e *literalExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	return e.val, expression.NewExplanation("literal", "", e.val, nil), nil
+}
+
+func (d *IsDefinedExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	val, err := d.Eval(scope)
+	return val, expression.NewExplanation("function", "isdefined", val, err), err
+}
+
+func (d *GetValueExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	val, err := d.Eval(scope)
+	return val, expression.NewExplanation("function", "getValue", val, err), err
+}
+
+func (e *identExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	val, err := e.Eval(scope)
+	return val, expression.NewExplanation("reference", refText(append([]interface{}{e.name}, e.fields...)), val, err), err
+}
+
+func (e *lambdaExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	val, err := e.Eval(scope)
+	ex := expression.NewExplanation("lambda", "("+strings.Join(e.params, ", ")+") => ...", val, err)
+	return val, ex, err
+}
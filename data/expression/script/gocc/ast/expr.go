@@ -113,6 +113,7 @@ func NewRefExpr(refNode ...interface{}) (Expr, error) {
 
 type exprRef struct {
 	fields       []interface{}
+	safeFields   []interface{}
 	res          resolve.Resolution
 	resolver     resolve.CompositeResolver
 	hasIndexExpr bool
@@ -120,6 +121,20 @@ type exprRef struct {
 
 func (e *exprRef) Init(resolver resolve.CompositeResolver, root bool) error {
 	e.resolver = resolver
+
+	// the fields from the first safe navigation step on are applied to the resolved value
+	for i, v := range e.fields {
+		if _, ok := v.(*safeNav); ok {
+			e.safeFields = e.fields[i:]
+			e.fields = e.fields[:i]
+			break
+		}
+	}
+	err := initPath(e.safeFields, resolver)
+	if err != nil {
+		return err
+	}
+
 	for _, v := range e.fields {
 		switch t := v.(type) {
 		case Expr:
@@ -148,14 +163,27 @@ func (e *exprRef) Init(resolver resolve.CompositeResolver, root bool) error {
 }
 
 func (e *exprRef) Eval(scope data.Scope) (data interface{}, err error) {
+	res := e.res
 	if e.hasIndexExpr {
-		//Get final resolved ref from index expression
-		e.res, err = e.constructRealRef(scope)
+		//Get final resolved ref from index expression, the expression can be evaluated concurrently so the
+		//resolution is not kept
+		res, err = e.constructRealRef(scope)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return e.res.GetValue(scope)
+	if len(e.safeFields) == 0 {
+		return res.GetValue(scope)
+	}
+
+	value, err := res.GetValue(scope)
+	if err != nil {
+		if resolve.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return navigate(value, e.safeFields, scope)
 }
 
 func (e *exprRef) constructRealRef(scope data.Scope) (resolve.Resolution, error) {
@@ -0,0 +1,204 @@
+package ast
+
+import (
+	"sync"
+
+	"github.com/project-flogo/core/data/coerce"
+)
+
+// lastEval records the operands, or the result, of the last evaluation of a root expression, which is
+// reported by Detail.  Only root expressions have one, nested expressions are not modified when evaluated,
+// and expressions reporting a detail are not cached so they are not shared by unrelated evaluations.
+type lastEval struct {
+	mutex       sync.Mutex
+	evaluated   bool
+	left, right interface{}
+}
+
+// newLastEval returns the record of the last evaluation for a root expression, nil otherwise
+func newLastEval(root bool) *lastEval {
+	if !root {
+		return nil
+	}
+	return &lastEval{}
+}
+
+func (l *lastEval) record(left, right interface{}) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	l.evaluated, l.left, l.right = true, left, right
+	l.mutex.Unlock()
+}
+
+func (l *lastEval) load() (left, right interface{}, evaluated bool) {
+	if l == nil {
+		return nil, nil, false
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.left, l.right, l.evaluated
+}
+
+// operatorDetail describes an operator and the operands it was last evaluated with
+func (l *lastEval) operatorDetail(name, symbol string) ExprEvalData {
+	detail := ExprEvalData{ExpressionType: "operator", ExpressionName: name}
+	if left, right, evaluated := l.load(); evaluated {
+		detail.ExpressionEvaluation = formatOperands(left, symbol, right)
+	}
+	return detail
+}
+
+// functionDetail describes a function and the result of its last evaluation
+func (l *lastEval) functionDetail(name string) ExprEvalData {
+	detail := ExprEvalData{ExpressionType: "function", ExpressionName: name}
+	if result, _, evaluated := l.load(); evaluated {
+		detail.ExpressionEvaluation, _ = coerce.ToString(result)
+	}
+	return detail
+}
+
+// formatOperands formats the evaluation of a binary operator, ex. 1 == 2
+func formatOperands(lv interface{}, symbol string, rv interface{}) string {
+	leftValue, _ := coerce.ToString(lv)
+	rightValue, _ := coerce.ToString(rv)
+	return leftValue + " " + symbol + " " + rightValue
+}
+
+type cmpOp int
+
+const (
+	opEq cmpOp = iota
+	opNotEq
+	opGt
+	opGtEq
+	opLt
+	opLtEq
+)
+
+// fastCompare compares operands of the same common type without reflection or coercion, ok is false if
+// the operands have to be compared using the general rules
+func fastCompare(op cmpOp, lv, rv interface{}) (result, ok bool) {
+	switch l := lv.(type) {
+	case int:
+		if r, ok := rv.(int); ok {
+			return compareInt64(op, int64(l), int64(r)), true
+		}
+	case int64:
+		if r, ok := rv.(int64); ok {
+			return compareInt64(op, l, r), true
+		}
+	case float64:
+		if r, ok := rv.(float64); ok {
+			return compareFloat64(op, l, r), true
+		}
+	case string:
+		if r, ok := rv.(string); ok {
+			return compareString(op, l, r), true
+		}
+	case bool:
+		if r, ok := rv.(bool); ok {
+			switch op {
+			case opEq:
+				return l == r, true
+			case opNotEq:
+				return l != r, true
+			}
+		}
+	}
+	return false, false
+}
+
+func compareInt64(op cmpOp, l, r int64) bool {
+	switch op {
+	case opEq:
+		return l == r
+	case opNotEq:
+		return l != r
+	case opGt:
+		return l > r
+	case opGtEq:
+		return l >= r
+	case opLt:
+		return l < r
+	default:
+		return l <= r
+	}
+}
+
+func compareFloat64(op cmpOp, l, r float64) bool {
+	switch op {
+	case opEq:
+		return l == r
+	case opNotEq:
+		return l != r
+	case opGt:
+		return l > r
+	case opGtEq:
+		return l >= r
+	case opLt:
+		return l < r
+	default:
+		return l <= r
+	}
+}
+
+func compareString(op cmpOp, l, r string) bool {
+	switch op {
+	case opEq:
+		return l == r
+	case opNotEq:
+		return l != r
+	case opGt:
+		return l > r
+	case opGtEq:
+		return l >= r
+	case opLt:
+		return l < r
+	default:
+		return l <= r
+	}
+}
+
+// fastArith applies an arithmetic operator to operands of the same common type without reflection or
+// coercion, ok is false if the operator has to be applied using the general rules
+func fastArith(op byte, lv, rv interface{}) (result interface{}, ok bool) {
+	switch l := lv.(type) {
+	case int:
+		if r, ok := rv.(int); ok {
+			switch op {
+			case '+':
+				return l + r, true
+			case '-':
+				return l - r, true
+			case '*':
+				return l * r, true
+			case '/':
+				return l / r, true
+			case '%':
+				return l % r, true
+			}
+		}
+	case float64:
+		if r, ok := rv.(float64); ok {
+			switch op {
+			case '+':
+				return l + r, true
+			case '-':
+				return l - r, true
+			case '*':
+				return l * r, true
+			case '/':
+				return l / r, true
+			case '%':
+				return int(l) % int(r), true
+			}
+		}
+	case string:
+		if r, ok := rv.(string); ok && op == '+' {
+			return l + r, true
+		}
+	}
+	return nil, false
+}
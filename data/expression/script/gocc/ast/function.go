@@ -2,7 +2,6 @@ package ast
 
 import (
 	"fmt"
-	"github.com/project-flogo/core/data/coerce"
 	"strings"
 
 	"github.com/project-flogo/core/data"
@@ -40,12 +39,13 @@ func NewFuncExpr(name interface{}, args interface{}) (Expr, error) {
 }
 
 type funcExpr struct {
-	f          function.Function
-	params     []Expr
-	evalResult ExprEvalData
+	f      function.Function
+	params []Expr
+	last   *lastEval
 }
 
 func (e *funcExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
 	for _, param := range e.params {
 		err := param.Init(resolver, false)
 		if err != nil {
@@ -66,17 +66,11 @@ func (e *funcExpr) Eval(scope data.Scope) (interface{}, error) {
 		vals[idx] = v
 	}
 
-	evalResult := ExprEvalData{
-		ExpressionType: "function",
-		ExpressionName: e.f.Name(),
-	}
-
 	eval, err := function.Eval(e.f, vals...)
-	evalResult.ExpressionEvaluation, _ = coerce.ToString(eval)
-	e.evalResult = evalResult
+	e.last.record(eval, nil)
 	return eval, err
 }
 
 func (e *funcExpr) Detail() ExprEvalData {
-	return e.evalResult
+	return e.last.functionDetail(e.f.Name())
 }
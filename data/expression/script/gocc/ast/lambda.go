@@ -0,0 +1,157 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression/function"
+	"github.com/project-flogo/core/data/expression/script/gocc/token"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+func NewLambdaParams(first, second interface{}) ([]string, error) {
+	return AppendToLambdaParams([]string{string(first.(*token.Token).Lit)}, second)
+}
+
+func AppendToLambdaParams(list, param interface{}) ([]string, error) {
+	params, ok := list.([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid lambda parameter list type; expected []string, got %T", list)
+	}
+
+	name := string(param.(*token.Token).Lit)
+	for _, p := range params {
+		if p == name {
+			return nil, fmt.Errorf("duplicate lambda parameter '%s'", name)
+		}
+	}
+	return append(params, name), nil
+}
+
+func NewLambdaExpr(params interface{}, body interface{}) (Expr, error) {
+	bodyExpr, ok := body.(Expr)
+	if !ok {
+		return nil, fmt.Errorf("invalid lambda body type; expected ast.Expr, got %T", body)
+	}
+
+	switch t := params.(type) {
+	case nil:
+		return &lambdaExpr{body: bodyExpr}, nil
+	case *token.Token:
+		return &lambdaExpr{params: []string{string(t.Lit)}, body: bodyExpr}, nil
+	case []string:
+		return &lambdaExpr{params: t, body: bodyExpr}, nil
+	}
+
+	return nil, fmt.Errorf("invalid lambda parameters type %T", params)
+}
+
+// lambdaExpr is an anonymous function, e.g. x => x.price > 10, it evaluates to a function.Lambda
+// that evaluates its body in a scope holding its parameters
+type lambdaExpr struct {
+	params []string
+	body   Expr
+}
+
+func (e *lambdaExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	return e.body.Init(&lambdaResolver{CompositeResolver: resolver, params: e.params}, false)
+}
+
+func (e *lambdaExpr) Eval(scope data.Scope) (interface{}, error) {
+	return &lambda{expr: e, scope: scope}, nil
+}
+
+// lambdaResolver tracks the lambda parameters that are in scope while the body of a lambda is initialized
+type lambdaResolver struct {
+	resolve.CompositeResolver
+	params []string
+}
+
+func isLambdaParam(resolver resolve.CompositeResolver, name string) bool {
+	for r, ok := resolver.(*lambdaResolver); ok; r, ok = r.CompositeResolver.(*lambdaResolver) {
+		for _, p := range r.params {
+			if p == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lambda is a lambdaExpr bound to the scope it was evaluated in
+type lambda struct {
+	expr  *lambdaExpr
+	scope data.Scope
+}
+
+func (l *lambda) Params() []string {
+	return l.expr.params
+}
+
+func (l *lambda) Call(args ...interface{}) (interface{}, error) {
+	values := make(map[string]interface{}, len(l.expr.params))
+	for i, p := range l.expr.params {
+		if i < len(args) {
+			values[p] = args[i]
+		} else {
+			values[p] = nil
+		}
+	}
+
+	return l.expr.body.Eval(data.NewSimpleScope(values, l.scope))
+}
+
+func (l *lambda) String() string {
+	return "(" + strings.Join(l.expr.params, ", ") + ") => ..."
+}
+
+var _ function.Lambda = (*lambda)(nil)
+
+func NewIdentExpr(ident interface{}, ref ...interface{}) (Expr, error) {
+	name := string(ident.(*token.Token).Lit)
+
+	var fields []interface{}
+	if idx := strings.Index(name, "."); idx > 0 {
+		fields = append(fields, name[idx:])
+		name = name[:idx]
+	}
+
+	refFields, err := Concat(ref...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identExpr{name: name, fields: append(fields, refFields...)}, nil
+}
+
+// identExpr references a lambda parameter, optionally followed by a path into its value, e.g. x.items[0].price
+type identExpr struct {
+	name   string
+	fields []interface{}
+}
+
+func (e *identExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	if !isLambdaParam(resolver, e.name) {
+		return fmt.Errorf("unknown identifier '%s'", e.name)
+	}
+
+	return initPath(e.fields, resolver)
+}
+
+func (e *identExpr) Eval(scope data.Scope) (interface{}, error) {
+	if scope == nil {
+		return nil, fmt.Errorf("identifier '%s' is not in scope", e.name)
+	}
+
+	value, exists := scope.GetValue(e.name)
+	if !exists {
+		return nil, fmt.Errorf("identifier '%s' is not in scope", e.name)
+	}
+
+	if len(e.fields) == 0 || value == nil {
+		return value, nil
+	}
+
+	return navigate(value, e.fields, scope)
+}
@@ -0,0 +1,253 @@
+package ast
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+type matchOp int
+
+const (
+	opMatch matchOp = iota
+	opNotMatch
+	opIn
+	opNotIn
+	opContains
+	opStartsWith
+	opEndsWith
+)
+
+var matchOps = map[string]matchOp{
+	"=~":         opMatch,
+	"!~":         opNotMatch,
+	"in":         opIn,
+	"contains":   opContains,
+	"startsWith": opStartsWith,
+	"endsWith":   opEndsWith,
+}
+
+var matchOpNames = map[matchOp][2]string{
+	opMatch:      {"matches", "=~"},
+	opNotMatch:   {"does not match", "!~"},
+	opIn:         {"in", "in"},
+	opNotIn:      {"not in", "not in"},
+	opContains:   {"contains", "contains"},
+	opStartsWith: {"starts with", "startsWith"},
+	opEndsWith:   {"ends with", "endsWith"},
+}
+
+func NewNotInExpr(left, right interface{}) (Expr, error) {
+	return &matchExpr{op: opNotIn, left: left.(Expr), right: right.(Expr)}, nil
+}
+
+// matchExpr is a string matching or membership operator, a regular expression that is a string literal is
+// compiled when the expression is initialized, otherwise compiled patterns are cached
+type matchExpr struct {
+	op          matchOp
+	left, right Expr
+	re          *regexp.Regexp
+	last        *lastEval
+}
+
+func (e *matchExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	e.last = newLastEval(root)
+	err := e.left.Init(resolver, false)
+	if err != nil {
+		return err
+	}
+	err = e.right.Init(resolver, false)
+	if err != nil {
+		return err
+	}
+
+	if e.op == opMatch || e.op == opNotMatch {
+		if lit, ok := e.right.(*literalExpr); ok {
+			if pattern, ok := lit.val.(string); ok {
+				e.re, err = compileRegex(pattern)
+			}
+		}
+	}
+	return err
+}
+
+func (e *matchExpr) Eval(scope data.Scope) (interface{}, error) {
+	lv, rv, err := evalLR(e.left, e.right, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	e.last.record(lv, rv)
+	return e.apply(lv, rv)
+}
+
+func (e *matchExpr) apply(lv, rv interface{}) (bool, error) {
+	switch e.op {
+	case opMatch, opNotMatch:
+		matched, err := e.match(lv, rv)
+		return matched == (e.op == opMatch), err
+	case opIn:
+		return contains(rv, lv)
+	case opNotIn:
+		found, err := contains(rv, lv)
+		return !found, err
+	case opContains:
+		return contains(lv, rv)
+	}
+
+	if lv == nil || rv == nil {
+		return false, nil
+	}
+	ls, err := coerce.ToString(lv)
+	if err != nil {
+		return false, err
+	}
+	rs, err := coerce.ToString(rv)
+	if err != nil {
+		return false, err
+	}
+	if e.op == opStartsWith {
+		return strings.HasPrefix(ls, rs), nil
+	}
+	return strings.HasSuffix(ls, rs), nil
+}
+
+func (e *matchExpr) match(lv, rv interface{}) (bool, error) {
+	if lv == nil {
+		return false, nil
+	}
+	s, err := coerce.ToString(lv)
+	if err != nil {
+		return false, err
+	}
+
+	re := e.re
+	if re == nil {
+		pattern, err := coerce.ToString(rv)
+		if err != nil {
+			return false, err
+		}
+		re, err = compileRegex(pattern)
+		if err != nil {
+			return false, err
+		}
+	}
+	return re.MatchString(s), nil
+}
+
+func (e *matchExpr) Detail() ExprEvalData {
+	names := matchOpNames[e.op]
+	return e.last.operatorDetail(names[0], names[1])
+}
+
+func (e *matchExpr) Check(env *expression.CheckEnv) (data.Type, []error) {
+	return checkBool(e.left, e.right, env)
+}
+
+func (e *matchExpr) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	names := matchOpNames[e.op]
+	return explainOperator(names[0], names[1], e.left, e.right, scope, func(l, r Expr) Expr {
+		return &matchExpr{op: e.op, left: l, right: r, re: e.re}
+	})
+}
+
+// contains returns whether a string contains a substring, an array contains an element or an object
+// contains a key, nothing is contained in nil
+func contains(container, item interface{}) (bool, error) {
+	switch c := container.(type) {
+	case nil:
+		return false, nil
+	case string:
+		s, err := coerce.ToString(item)
+		if err != nil {
+			return false, err
+		}
+		return strings.Contains(c, s), nil
+	case []interface{}:
+		for _, elem := range c {
+			if isEqual(elem, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]interface{}:
+		key, err := coerce.ToString(item)
+		if err != nil {
+			return false, err
+		}
+		_, found := c[key]
+		return found, nil
+	}
+
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if isEqual(v.Index(i).Interface(), item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return false, fmt.Errorf("unsupported map key type %s for membership", v.Type().Key())
+		}
+		key, err := coerce.ToString(item)
+		if err != nil {
+			return false, err
+		}
+		return v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())).IsValid(), nil
+	}
+	return false, fmt.Errorf("unable to check membership in value of type %T", container)
+}
+
+const regexCacheSize = 256
+
+// regexps is the cache of the regular expressions compiled during evaluation, the least recently used
+// expression is evicted when the cache is full
+var regexps = &regexCache{entries: make(map[string]*list.Element), lru: list.New()}
+
+type regexCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	return regexps.get(pattern)
+}
+
+func (c *regexCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*regexp.Regexp), nil
+	}
+	c.mu.Unlock()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %s", pattern, err.Error())
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[pattern]; !ok {
+		c.entries[pattern] = c.lru.PushFront(re)
+		for c.lru.Len() > regexCacheSize {
+			elem := c.lru.Back()
+			c.lru.Remove(elem)
+			delete(c.entries, elem.Value.(*regexp.Regexp).String())
+		}
+	}
+	return re, nil
+}
@@ -18,6 +18,8 @@ func Concat(items ...interface{}) ([]interface{}, error) {
 			array = append(array, string(t.Lit))
 		case Expr:
 			array = append(array, t)
+		case *safeNav:
+			array = append(array, t)
 		case []interface{}:
 			array = append(array, t...)
 		}
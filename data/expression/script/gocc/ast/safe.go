@@ -0,0 +1,184 @@
+package ast
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression/script/gocc/token"
+	"github.com/project-flogo/core/data/path"
+	"github.com/project-flogo/core/data/resolve"
+)
+
+func NewCoalesceExpr(left, right interface{}) (Expr, error) {
+	return &coalesceExpr{left: left.(Expr), right: right.(Expr)}, nil
+}
+
+// coalesceExpr evaluates to the left operand, unless it is nil or the value it references does not exist,
+// in which case it evaluates to the right operand, e.g. $.name ?? "unknown"
+type coalesceExpr struct {
+	left, right Expr
+}
+
+func (e *coalesceExpr) Init(resolver resolve.CompositeResolver, root bool) error {
+	err := e.left.Init(resolver, false)
+	if err != nil {
+		return err
+	}
+	return e.right.Init(resolver, false)
+}
+
+func (e *coalesceExpr) Eval(scope data.Scope) (interface{}, error) {
+	lv, err := e.left.Eval(scope)
+	if err != nil {
+		if !resolve.IsNotFound(err) {
+			return nil, err
+		}
+	} else if lv != nil {
+		return lv, nil
+	}
+
+	return e.right.Eval(scope)
+}
+
+func NewSafeSelector(selector interface{}) (interface{}, error) {
+	lit := string(selector.(*token.Token).Lit)
+	return &safeNav{name: strings.TrimPrefix(lit, "?.")}, nil
+}
+
+func NewSafeIndex(index interface{}) (interface{}, error) {
+	expr, ok := index.(Expr)
+	if !ok {
+		return nil, fmt.Errorf("invalid safe index expression type; expected ast.Expr, got %T", index)
+	}
+	return &safeNav{index: expr}, nil
+}
+
+// safeNav is a safe navigation step of a reference, ?.name or ?[index], if the value it is applied to
+// is nil the reference evaluates to nil, as does a missing key or an index out of range
+type safeNav struct {
+	name  string
+	index Expr
+}
+
+func (s *safeNav) Init(resolver resolve.CompositeResolver) error {
+	if s.index != nil {
+		return s.index.Init(resolver, false)
+	}
+	return nil
+}
+
+func (s *safeNav) get(value interface{}, scope data.Scope) (interface{}, error) {
+	if s.index == nil {
+		return getKey(value, s.name, "."+s.name)
+	}
+
+	key, err := s.index.Eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if k, ok := key.(string); ok {
+		return getKey(value, k, `["`+k+`"]`)
+	}
+
+	idx, err := coerce.ToInt(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index '%v': %s", key, err.Error())
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		if idx < 0 || idx >= rv.Len() {
+			return nil, nil
+		}
+		return rv.Index(idx).Interface(), nil
+	}
+
+	return path.GetValue(value, "["+strconv.Itoa(idx)+"]")
+}
+
+func getKey(value interface{}, key, keyPath string) (interface{}, error) {
+	switch t := value.(type) {
+	case map[string]interface{}:
+		return t[key], nil
+	case map[string]string:
+		if v, exists := t[key]; exists {
+			return v, nil
+		}
+		return nil, nil
+	case string:
+		// only strings holding a JSON document have keys
+		if s := strings.TrimSpace(t); !strings.HasPrefix(s, "{") && !strings.HasPrefix(s, "[") {
+			return nil, nil
+		}
+	case bool, int, int32, int64, float32, float64:
+		return nil, nil
+	}
+	return path.GetValue(value, keyPath)
+}
+
+// initPath initializes the index expressions of the path fields
+func initPath(fields []interface{}, resolver resolve.CompositeResolver) error {
+	for _, f := range fields {
+		var err error
+		switch t := f.(type) {
+		case *safeNav:
+			err = t.Init(resolver)
+		case Expr:
+			err = t.Init(resolver, false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// navigate applies the path fields to the value, a safe navigation step applied to nil short-circuits
+// the evaluation of the remaining fields to nil
+func navigate(value interface{}, fields []interface{}, scope data.Scope) (interface{}, error) {
+	var p strings.Builder
+	var err error
+
+	flush := func() error {
+		if p.Len() == 0 {
+			return nil
+		}
+		value, err = path.GetValue(value, p.String())
+		p.Reset()
+		return err
+	}
+
+	for _, f := range fields {
+		switch t := f.(type) {
+		case *safeNav:
+			if err = flush(); err != nil {
+				return nil, err
+			}
+			if value == nil {
+				return nil, nil
+			}
+			value, err = t.get(value, scope)
+			if err != nil {
+				return nil, err
+			}
+		case Expr:
+			idx, err := t.Eval(scope)
+			if err != nil {
+				return nil, err
+			}
+			p.WriteString(idx.(string))
+		case string:
+			p.WriteString(t)
+		}
+	}
+
+	if err = flush(); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
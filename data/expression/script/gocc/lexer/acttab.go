@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 15,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -37,11 +37,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 29,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 14,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S6
@@ -53,27 +53,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 17,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 18,
+		Accept: 26,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 12,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 10,
+		Accept: 18,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 34,
+		Accept: 43,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 11,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S14
@@ -81,23 +81,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 13,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 26,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 26,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 21,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 6,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S20
@@ -105,23 +105,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S21
-		Accept: 8,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S22
-		Accept: 20,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S24
-		Accept: 31,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S25
-		Accept: 32,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S26
@@ -129,155 +129,155 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S29
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: 0,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 5,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 28,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 0,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 29,
+		Accept: 44,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S36
-		Accept: 28,
+		Accept: 45,
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 0,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 27,
+		Accept: 12,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 30,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: 27,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 0,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S43
-		Accept: 7,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S45
-		Accept: 9,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S46
-		Accept: 19,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 16,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S48
-		Accept: 28,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S49
-		Accept: 16,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S50
-		Accept: 16,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 16,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: 16,
+		Accept: 47,
 		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: 2,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 0,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: 29,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 0,
+		Accept: 2,
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 0,
+		Accept: 42,
 		Ignore: "",
 	},
 	ActionRow{ // S58
-		Accept: 30,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S59
-		Accept: 27,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S60
-		Accept: 0,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S61
-		Accept: 0,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S62
-		Accept: 27,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S63
-		Accept: 19,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S64
-		Accept: 16,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S65
@@ -285,47 +285,207 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S66
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S67
-		Accept: 16,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S68
-		Accept: 0,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S69
-		Accept: 27,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 27,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S72
-		Accept: 19,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S73
-		Accept: 16,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S74
-		Accept: 25,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S75
-		Accept: 22,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S76
-		Accept: 23,
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S77
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S78
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S79
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S80
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S81
+		Accept: 27,
+		Ignore: "",
+	},
+	ActionRow{ // S82
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S83
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S84
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S85
+		Accept: 32,
+		Ignore: "",
+	},
+	ActionRow{ // S86
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S87
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S88
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S89
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S90
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S91
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S94
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 27,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S97
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S98
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S99
+		Accept: 33,
+		Ignore: "",
+	},
+	ActionRow{ // S100
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S101
+		Accept: 30,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 31,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S116
+		Accept: 16,
 		Ignore: "",
 	},
 }
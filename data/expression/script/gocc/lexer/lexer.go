@@ -3,7 +3,7 @@
 package lexer
 
 import (
-	"io/ioutil"
+	"os"
 	"unicode/utf8"
 
 	"github.com/project-flogo/core/data/expression/script/gocc/token"
@@ -11,40 +11,55 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 77
-	NumSymbols = 73
+	NumStates  = 117
+	NumSymbols = 118
 )
 
 type Lexer struct {
-	src    []byte
-	pos    int
-	line   int
-	column int
+	src     []byte
+	pos     int
+	line    int
+	column  int
+	Context token.Context
 }
 
 func NewLexer(src []byte) *Lexer {
 	lexer := &Lexer{
-		src:    src,
-		pos:    0,
-		line:   1,
-		column: 1,
+		src:     src,
+		pos:     0,
+		line:    1,
+		column:  1,
+		Context: nil,
 	}
 	return lexer
 }
 
+// SourceContext is a simple instance of a token.Context which
+// contains the name of the source file.
+type SourceContext struct {
+	Filepath string
+}
+
+func (s *SourceContext) Source() string {
+	return s.Filepath
+}
+
 func NewLexerFile(fpath string) (*Lexer, error) {
-	src, err := ioutil.ReadFile(fpath)
+	src, err := os.ReadFile(fpath)
 	if err != nil {
 		return nil, err
 	}
-	return NewLexer(src), nil
+	lexer := NewLexer(src)
+	lexer.Context = &SourceContext{Filepath: fpath}
+	return lexer, nil
 }
 
 func (l *Lexer) Scan() (tok *token.Token) {
-	tok = new(token.Token)
+	tok = &token.Token{}
 	if l.pos >= len(l.src) {
 		tok.Type = token.EOF
 		tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = l.pos, l.line, l.column
+		tok.Pos.Context = l.Context
 		return
 	}
 	start, startLine, startColumn, end := l.pos, l.line, l.column, 0
@@ -103,6 +118,7 @@ func (l *Lexer) Scan() (tok *token.Token) {
 		tok.Lit = []byte{}
 	}
 	tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = start, startLine, startColumn
+	tok.Pos.Context = l.Context
 
 	return
 }
@@ -118,72 +134,117 @@ Lexer symbols:
 2: '.'
 3: '$'
 4: '.'
-5: '.'
-6: '|'
-7: '|'
-8: '&'
-9: '&'
-10: '='
-11: '='
-12: '!'
-13: '='
-14: '<'
-15: '<'
-16: '='
-17: '>'
-18: '>'
-19: '='
-20: '+'
-21: '-'
-22: '*'
-23: '/'
-24: '%'
-25: '!'
-26: '('
-27: ')'
-28: '?'
-29: ':'
-30: 't'
-31: 'r'
-32: 'u'
-33: 'e'
-34: 'f'
-35: 'a'
-36: 'l'
-37: 's'
-38: 'e'
+5: '?'
+6: '.'
+7: '.'
+8: '?'
+9: '?'
+10: '|'
+11: '|'
+12: '&'
+13: '&'
+14: '='
+15: '='
+16: '!'
+17: '='
+18: '<'
+19: '<'
+20: '='
+21: '>'
+22: '>'
+23: '='
+24: '='
+25: '~'
+26: '!'
+27: '~'
+28: 'i'
+29: 'n'
+30: 'n'
+31: 'o'
+32: 't'
+33: 'c'
+34: 'o'
+35: 'n'
+36: 't'
+37: 'a'
+38: 'i'
 39: 'n'
-40: 'i'
-41: 'l'
-42: 'n'
-43: 'u'
-44: 'l'
-45: 'l'
-46: '['
-47: ']'
-48: ','
-49: '_'
-50: 'e'
-51: 'E'
-52: '+'
-53: '-'
-54: '\'
-55: '"'
-56: '"'
-57: '''
-58: '''
-59: '`'
-60: '`'
-61: '_'
-62: ' '
-63: '\t'
-64: '\v'
-65: '\f'
-66: '\r'
-67: '\n'
-68: '1'-'9'
-69: 'a'-'z'
-70: 'A'-'Z'
-71: '0'-'9'
-72: .
+40: 's'
+41: 's'
+42: 't'
+43: 'a'
+44: 'r'
+45: 't'
+46: 's'
+47: 'W'
+48: 'i'
+49: 't'
+50: 'h'
+51: 'e'
+52: 'n'
+53: 'd'
+54: 's'
+55: 'W'
+56: 'i'
+57: 't'
+58: 'h'
+59: '+'
+60: '-'
+61: '*'
+62: '/'
+63: '%'
+64: '!'
+65: '('
+66: ')'
+67: '?'
+68: ':'
+69: 't'
+70: 'r'
+71: 'u'
+72: 'e'
+73: 'f'
+74: 'a'
+75: 'l'
+76: 's'
+77: 'e'
+78: 'n'
+79: 'i'
+80: 'l'
+81: 'n'
+82: 'u'
+83: 'l'
+84: 'l'
+85: '['
+86: ']'
+87: '?'
+88: '['
+89: ','
+90: '{'
+91: '}'
+92: '='
+93: '>'
+94: '_'
+95: 'e'
+96: 'E'
+97: '+'
+98: '-'
+99: '\'
+100: '"'
+101: '"'
+102: '''
+103: '''
+104: '`'
+105: '`'
+106: '_'
+107: ' '
+108: '\t'
+109: '\v'
+110: '\f'
+111: '\r'
+112: '\n'
+113: '1'-'9'
+114: 'a'-'z'
+115: 'A'-'Z'
+116: '0'-'9'
+117: .
 */
@@ -77,22 +77,38 @@ var TransTab = TransitionTable{
 			return 23
 		case r == 96: // ['`','`']
 			return 26
-		case 97 <= r && r <= 101: // ['a','e']
+		case 97 <= r && r <= 98: // ['a','b']
 			return 23
-		case r == 102: // ['f','f']
+		case r == 99: // ['c','c']
 			return 27
-		case 103 <= r && r <= 109: // ['g','m']
+		case r == 100: // ['d','d']
 			return 23
-		case r == 110: // ['n','n']
+		case r == 101: // ['e','e']
 			return 28
-		case 111 <= r && r <= 115: // ['o','s']
+		case r == 102: // ['f','f']
+			return 29
+		case 103 <= r && r <= 104: // ['g','h']
+			return 23
+		case r == 105: // ['i','i']
+			return 30
+		case 106 <= r && r <= 109: // ['j','m']
 			return 23
+		case r == 110: // ['n','n']
+			return 31
+		case 111 <= r && r <= 114: // ['o','r']
+			return 23
+		case r == 115: // ['s','s']
+			return 32
 		case r == 116: // ['t','t']
-			return 29
+			return 33
 		case 117 <= r && r <= 122: // ['u','z']
 			return 23
+		case r == 123: // ['{','{']
+			return 34
 		case r == 124: // ['|','|']
-			return 30
+			return 35
+		case r == 125: // ['}','}']
+			return 36
 		}
 		return NoState
 	},
@@ -106,7 +122,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 31
+			return 37
+		case r == 126: // ['~','~']
+			return 38
 		}
 		return NoState
 	},
@@ -114,9 +132,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 34: // ['"','"']
-			return 32
+			return 39
 		case r == 92: // ['\','\']
-			return 33
+			return 40
 		default:
 			return 3
 		}
@@ -125,11 +143,11 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
+			return 41
 		case r == 95: // ['_','_']
-			return 34
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 41
 		}
 		return NoState
 	},
@@ -143,7 +161,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 38: // ['&','&']
-			return 35
+			return 42
 		}
 		return NoState
 	},
@@ -151,9 +169,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 36
+			return 43
 		case r == 92: // ['\','\']
-			return 37
+			return 44
 		default:
 			return 7
 		}
@@ -198,13 +216,13 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 39
+			return 46
 		case r == 95: // ['_','_']
-			return 39
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 39
+			return 46
 		}
 		return NoState
 	},
@@ -218,13 +236,13 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 40
+			return 47
 		case 48 <= r && r <= 57: // ['0','9']
-			return 41
+			return 48
 		case r == 69: // ['E','E']
-			return 42
+			return 49
 		case r == 101: // ['e','e']
-			return 42
+			return 49
 		}
 		return NoState
 	},
@@ -232,13 +250,13 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 40
+			return 47
 		case 48 <= r && r <= 57: // ['0','9']
 			return 17
 		case r == 69: // ['E','E']
-			return 42
+			return 49
 		case r == 101: // ['e','e']
-			return 42
+			return 49
 		}
 		return NoState
 	},
@@ -252,7 +270,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 43
+			return 50
 		}
 		return NoState
 	},
@@ -260,7 +278,11 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 44
+			return 51
+		case r == 62: // ['>','>']
+			return 52
+		case r == 126: // ['~','~']
+			return 53
 		}
 		return NoState
 	},
@@ -268,13 +290,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 45
+			return 54
 		}
 		return NoState
 	},
 	// S22
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 55
+		case r == 63: // ['?','?']
+			return 56
+		case r == 91: // ['[','[']
+			return 57
 		}
 		return NoState
 	},
@@ -282,9 +310,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
@@ -310,7 +338,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 96: // ['`','`']
-			return 48
+			return 60
 		default:
 			return 26
 		}
@@ -319,16 +347,18 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case r == 97: // ['a','a']
-			return 49
-		case 98 <= r && r <= 122: // ['b','z']
+		case 97 <= r && r <= 110: // ['a','n']
+			return 23
+		case r == 111: // ['o','o']
+			return 61
+		case 112 <= r && r <= 122: // ['p','z']
 			return 23
 		}
 		return NoState
@@ -337,22 +367,18 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 104: // ['a','h']
-			return 23
-		case r == 105: // ['i','i']
-			return 50
-		case 106 <= r && r <= 116: // ['j','t']
+		case 97 <= r && r <= 109: // ['a','m']
 			return 23
-		case r == 117: // ['u','u']
-			return 51
-		case 118 <= r && r <= 122: // ['v','z']
+		case r == 110: // ['n','n']
+			return 62
+		case 111 <= r && r <= 122: // ['o','z']
 			return 23
 		}
 		return NoState
@@ -361,18 +387,16 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 113: // ['a','q']
-			return 23
-		case r == 114: // ['r','r']
-			return 52
-		case 115 <= r && r <= 122: // ['s','z']
+		case r == 97: // ['a','a']
+			return 63
+		case 98 <= r && r <= 122: // ['b','z']
 			return 23
 		}
 		return NoState
@@ -380,47 +404,102 @@ var TransTab = TransitionTable{
 	// S30
 	func(r rune) int {
 		switch {
-		case r == 124: // ['|','|']
-			return 53
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 109: // ['a','m']
+			return 23
+		case r == 110: // ['n','n']
+			return 64
+		case 111 <= r && r <= 122: // ['o','z']
+			return 23
 		}
 		return NoState
 	},
 	// S31
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 23
+		case r == 105: // ['i','i']
+			return 65
+		case 106 <= r && r <= 110: // ['j','n']
+			return 23
+		case r == 111: // ['o','o']
+			return 66
+		case 112 <= r && r <= 116: // ['p','t']
+			return 23
+		case r == 117: // ['u','u']
+			return 67
+		case 118 <= r && r <= 122: // ['v','z']
+			return 23
 		}
 		return NoState
 	},
 	// S32
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 115: // ['a','s']
+			return 23
+		case r == 116: // ['t','t']
+			return 68
+		case 117 <= r && r <= 122: // ['u','z']
+			return 23
 		}
 		return NoState
 	},
 	// S33
 	func(r rune) int {
 		switch {
-		default:
-			return 54
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 113: // ['a','q']
+			return 23
+		case r == 114: // ['r','r']
+			return 69
+		case 115 <= r && r <= 122: // ['s','z']
+			return 23
 		}
+		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 55
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 122: // ['a','z']
-			return 34
 		}
 		return NoState
 	},
 	// S35
 	func(r rune) int {
 		switch {
+		case r == 124: // ['|','|']
+			return 70
 		}
 		return NoState
 	},
@@ -433,71 +512,45 @@ var TransTab = TransitionTable{
 	// S37
 	func(r rune) int {
 		switch {
-		default:
-			return 56
 		}
+		return NoState
 	},
 	// S38
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 38
-		case r == 69: // ['E','E']
-			return 57
-		case r == 101: // ['e','e']
-			return 57
 		}
 		return NoState
 	},
 	// S39
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 58
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 39
-		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 39
 		}
 		return NoState
 	},
 	// S40
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 59
-		case r == 69: // ['E','E']
-			return 60
-		case r == 101: // ['e','e']
-			return 60
+		default:
+			return 71
 		}
-		return NoState
 	},
 	// S41
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 40
 		case 48 <= r && r <= 57: // ['0','9']
+			return 72
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 41
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
 			return 41
-		case r == 69: // ['E','E']
-			return 42
-		case r == 101: // ['e','e']
-			return 42
 		}
 		return NoState
 	},
 	// S42
 	func(r rune) int {
 		switch {
-		case r == 43: // ['+','+']
-			return 61
-		case r == 45: // ['-','-']
-			return 61
-		case 48 <= r && r <= 57: // ['0','9']
-			return 62
 		}
 		return NoState
 	},
@@ -510,128 +563,89 @@ var TransTab = TransitionTable{
 	// S44
 	func(r rune) int {
 		switch {
+		default:
+			return 73
 		}
-		return NoState
 	},
 	// S45
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case r == 69: // ['E','E']
+			return 74
+		case r == 101: // ['e','e']
+			return 74
 		}
 		return NoState
 	},
 	// S46
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
+		case 48 <= r && r <= 57: // ['0','9']
+			return 75
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 63
+			return 46
 		case r == 95: // ['_','_']
-			return 63
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 63
+			return 46
 		}
 		return NoState
 	},
 	// S47
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 23
-		case r == 95: // ['_','_']
-			return 23
-		case 97 <= r && r <= 122: // ['a','z']
-			return 23
+			return 76
+		case r == 69: // ['E','E']
+			return 77
+		case r == 101: // ['e','e']
+			return 77
 		}
 		return NoState
 	},
 	// S48
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 47
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case r == 69: // ['E','E']
+			return 49
+		case r == 101: // ['e','e']
+			return 49
 		}
 		return NoState
 	},
 	// S49
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
+		case r == 43: // ['+','+']
+			return 78
+		case r == 45: // ['-','-']
+			return 78
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 23
-		case r == 95: // ['_','_']
-			return 23
-		case 97 <= r && r <= 107: // ['a','k']
-			return 23
-		case r == 108: // ['l','l']
-			return 64
-		case 109 <= r && r <= 122: // ['m','z']
-			return 23
+			return 79
 		}
 		return NoState
 	},
 	// S50
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
-		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 23
-		case r == 95: // ['_','_']
-			return 23
-		case 97 <= r && r <= 107: // ['a','k']
-			return 23
-		case r == 108: // ['l','l']
-			return 65
-		case 109 <= r && r <= 122: // ['m','z']
-			return 23
 		}
 		return NoState
 	},
 	// S51
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
-		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 23
-		case r == 95: // ['_','_']
-			return 23
-		case 97 <= r && r <= 107: // ['a','k']
-			return 23
-		case r == 108: // ['l','l']
-			return 66
-		case 109 <= r && r <= 122: // ['m','z']
-			return 23
 		}
 		return NoState
 	},
 	// S52
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
-		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 23
-		case r == 95: // ['_','_']
-			return 23
-		case 97 <= r && r <= 116: // ['a','t']
-			return 23
-		case r == 117: // ['u','u']
-			return 67
-		case 118 <= r && r <= 122: // ['v','z']
-			return 23
 		}
 		return NoState
 	},
@@ -644,102 +658,106 @@ var TransTab = TransitionTable{
 	// S54
 	func(r rune) int {
 		switch {
-		case r == 34: // ['"','"']
-			return 32
-		case r == 92: // ['\','\']
-			return 33
-		default:
-			return 3
 		}
+		return NoState
 	},
 	// S55
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 55
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
+			return 80
 		case r == 95: // ['_','_']
-			return 34
+			return 80
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 80
 		}
 		return NoState
 	},
 	// S56
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 36
-		case r == 92: // ['\','\']
-			return 37
-		default:
-			return 7
 		}
+		return NoState
 	},
 	// S57
 	func(r rune) int {
 		switch {
-		case r == 43: // ['+','+']
-			return 68
-		case r == 45: // ['-','-']
-			return 68
-		case 48 <= r && r <= 57: // ['0','9']
-			return 69
 		}
 		return NoState
 	},
 	// S58
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 46: // ['.','.']
 			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 39
+			return 81
 		case r == 95: // ['_','_']
-			return 39
+			return 81
 		case 97 <= r && r <= 122: // ['a','z']
-			return 39
+			return 81
 		}
 		return NoState
 	},
 	// S59
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
 			return 59
-		case r == 69: // ['E','E']
-			return 60
-		case r == 101: // ['e','e']
-			return 60
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
 		}
 		return NoState
 	},
 	// S60
 	func(r rune) int {
 		switch {
-		case r == 43: // ['+','+']
-			return 70
-		case r == 45: // ['-','-']
-			return 70
-		case 48 <= r && r <= 57: // ['0','9']
-			return 71
 		}
 		return NoState
 	},
 	// S61
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 62
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 109: // ['a','m']
+			return 23
+		case r == 110: // ['n','n']
+			return 82
+		case 111 <= r && r <= 122: // ['o','z']
+			return 23
 		}
 		return NoState
 	},
 	// S62
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 62
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 99: // ['a','c']
+			return 23
+		case r == 100: // ['d','d']
+			return 83
+		case 101 <= r && r <= 122: // ['e','z']
+			return 23
 		}
 		return NoState
 	},
@@ -747,15 +765,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 72
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 63
+			return 23
 		case r == 95: // ['_','_']
-			return 63
-		case 97 <= r && r <= 122: // ['a','z']
-			return 63
+			return 23
+		case 97 <= r && r <= 107: // ['a','k']
+			return 23
+		case r == 108: // ['l','l']
+			return 84
+		case 109 <= r && r <= 122: // ['m','z']
+			return 23
 		}
 		return NoState
 	},
@@ -763,18 +785,14 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 114: // ['a','r']
-			return 23
-		case r == 115: // ['s','s']
-			return 73
-		case 116 <= r && r <= 122: // ['t','z']
+		case 97 <= r && r <= 122: // ['a','z']
 			return 23
 		}
 		return NoState
@@ -783,14 +801,18 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 122: // ['a','z']
+		case 97 <= r && r <= 107: // ['a','k']
+			return 23
+		case r == 108: // ['l','l']
+			return 85
+		case 109 <= r && r <= 122: // ['m','z']
 			return 23
 		}
 		return NoState
@@ -799,18 +821,18 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 107: // ['a','k']
+		case 97 <= r && r <= 115: // ['a','s']
 			return 23
-		case r == 108: // ['l','l']
-			return 74
-		case 109 <= r && r <= 122: // ['m','z']
+		case r == 116: // ['t','t']
+			return 86
+		case 117 <= r && r <= 122: // ['u','z']
 			return 23
 		}
 		return NoState
@@ -819,18 +841,18 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 100: // ['a','d']
+		case 97 <= r && r <= 107: // ['a','k']
 			return 23
-		case r == 101: // ['e','e']
-			return 75
-		case 102 <= r && r <= 122: // ['f','z']
+		case r == 108: // ['l','l']
+			return 87
+		case 109 <= r && r <= 122: // ['m','z']
 			return 23
 		}
 		return NoState
@@ -838,95 +860,423 @@ var TransTab = TransitionTable{
 	// S68
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 69
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case r == 97: // ['a','a']
+			return 88
+		case 98 <= r && r <= 122: // ['b','z']
+			return 23
 		}
 		return NoState
 	},
 	// S69
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 69
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 116: // ['a','t']
+			return 23
+		case r == 117: // ['u','u']
+			return 89
+		case 118 <= r && r <= 122: // ['v','z']
+			return 23
 		}
 		return NoState
 	},
 	// S70
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 71
 		}
 		return NoState
 	},
 	// S71
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 71
+		case r == 34: // ['"','"']
+			return 39
+		case r == 92: // ['\','\']
+			return 40
+		default:
+			return 3
 		}
-		return NoState
 	},
 	// S72
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
 		case 48 <= r && r <= 57: // ['0','9']
 			return 72
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 63
+			return 41
 		case r == 95: // ['_','_']
-			return 63
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 63
+			return 41
 		}
 		return NoState
 	},
 	// S73
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 46
+		case r == 39: // [''',''']
+			return 43
+		case r == 92: // ['\','\']
+			return 44
+		default:
+			return 7
+		}
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 90
+		case r == 45: // ['-','-']
+			return 90
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
+			return 91
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 75
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 76
+		case r == 69: // ['E','E']
+			return 77
+		case r == 101: // ['e','e']
+			return 77
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 92
+		case r == 45: // ['-','-']
+			return 92
+		case 48 <= r && r <= 57: // ['0','9']
+			return 93
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 79
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 79
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 94
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 80
+		case r == 95: // ['_','_']
+			return 80
+		case 97 <= r && r <= 122: // ['a','z']
+			return 80
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 95
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 81
+		case r == 95: // ['_','_']
+			return 81
+		case 97 <= r && r <= 122: // ['a','z']
+			return 81
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 115: // ['a','s']
+			return 23
+		case r == 116: // ['t','t']
+			return 96
+		case 117 <= r && r <= 122: // ['u','z']
+			return 23
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 114: // ['a','r']
+			return 23
+		case r == 115: // ['s','s']
+			return 97
+		case 116 <= r && r <= 122: // ['t','z']
+			return 23
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 114: // ['a','r']
+			return 23
+		case r == 115: // ['s','s']
+			return 98
+		case 116 <= r && r <= 122: // ['t','z']
+			return 23
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 107: // ['a','k']
+			return 23
+		case r == 108: // ['l','l']
+			return 99
+		case 109 <= r && r <= 122: // ['m','z']
+			return 23
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 113: // ['a','q']
+			return 23
+		case r == 114: // ['r','r']
+			return 100
+		case 115 <= r && r <= 122: // ['s','z']
+			return 23
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
 		case 97 <= r && r <= 100: // ['a','d']
 			return 23
 		case r == 101: // ['e','e']
-			return 76
+			return 101
 		case 102 <= r && r <= 122: // ['f','z']
 			return 23
 		}
 		return NoState
 	},
-	// S74
+	// S90
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 91
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 91
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 93
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 93
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 94
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 80
+		case r == 95: // ['_','_']
+			return 80
+		case 97 <= r && r <= 122: // ['a','z']
+			return 80
+		}
+		return NoState
+	},
+	// S95
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 95
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 81
+		case r == 95: // ['_','_']
+			return 81
+		case 97 <= r && r <= 122: // ['a','z']
+			return 81
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
-		case 97 <= r && r <= 122: // ['a','z']
+		case r == 97: // ['a','a']
+			return 102
+		case 98 <= r && r <= 122: // ['b','z']
 			return 23
 		}
 		return NoState
 	},
-	// S75
+	// S97
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
+			return 59
+		case 65 <= r && r <= 86: // ['A','V']
+			return 23
+		case r == 87: // ['W','W']
+			return 103
+		case 88 <= r && r <= 90: // ['X','Z']
 			return 23
 		case r == 95: // ['_','_']
 			return 23
@@ -935,13 +1285,353 @@ var TransTab = TransitionTable{
 		}
 		return NoState
 	},
-	// S76
+	// S98
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 46
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 47
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 100: // ['a','d']
+			return 23
+		case r == 101: // ['e','e']
+			return 104
+		case 102 <= r && r <= 122: // ['f','z']
+			return 23
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 115: // ['a','s']
+			return 23
+		case r == 116: // ['t','t']
+			return 105
+		case 117 <= r && r <= 122: // ['u','z']
+			return 23
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 23
+		case r == 105: // ['i','i']
+			return 106
+		case 106 <= r && r <= 122: // ['j','z']
+			return 23
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 23
+		case r == 105: // ['i','i']
+			return 107
+		case 106 <= r && r <= 122: // ['j','z']
+			return 23
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 114: // ['a','r']
+			return 23
+		case r == 115: // ['s','s']
+			return 108
+		case 116 <= r && r <= 122: // ['t','z']
+			return 23
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 109: // ['a','m']
+			return 23
+		case r == 110: // ['n','n']
+			return 109
+		case 111 <= r && r <= 122: // ['o','z']
+			return 23
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 115: // ['a','s']
+			return 23
+		case r == 116: // ['t','t']
+			return 110
+		case 117 <= r && r <= 122: // ['u','z']
+			return 23
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 86: // ['A','V']
+			return 23
+		case r == 87: // ['W','W']
+			return 111
+		case 88 <= r && r <= 90: // ['X','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 114: // ['a','r']
+			return 23
+		case r == 115: // ['s','s']
+			return 112
+		case 116 <= r && r <= 122: // ['t','z']
+			return 23
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 103: // ['a','g']
+			return 23
+		case r == 104: // ['h','h']
+			return 113
+		case 105 <= r && r <= 122: // ['i','z']
+			return 23
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 23
+		case r == 105: // ['i','i']
+			return 114
+		case 106 <= r && r <= 122: // ['j','z']
+			return 23
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 23
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 115: // ['a','s']
+			return 23
+		case r == 116: // ['t','t']
+			return 115
+		case 117 <= r && r <= 122: // ['u','z']
+			return 23
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 23
+		case r == 95: // ['_','_']
+			return 23
+		case 97 <= r && r <= 103: // ['a','g']
+			return 23
+		case r == 104: // ['h','h']
+			return 116
+		case 105 <= r && r <= 122: // ['i','z']
+			return 23
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 23
 		case r == 95: // ['_','_']
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 19
+const numNTSymbols = 29
 
 type (
 	gotoTable [numStates]gotoRow
@@ -14,27 +14,38 @@ var gotoTab = gotoTable{
 		-1, // S'
 		1,  // Fscript
 		2,  // Expr
-		4,  // Expr1
-		5,  // Expr2
-		6,  // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
+		4,  // Expr0
+		5,  // Expr1
+		6,  // Expr2
+		7,  // Expr3
+		8,  // Expr4
+		10, // Expr5
+		11, // Expr6
+		13, // PrimaryExpr
 		3,  // TernaryExpr
-		17, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
+		20, // TernaryArgument
+		21, // BoolLit
+		24, // NilLit
+		17, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		18, // ArrayLit
+		-1, // Elements
+		19, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S1
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -49,13 +60,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S2
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -70,13 +91,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S3
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -91,13 +122,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S4
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -112,13 +153,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S5
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -133,13 +184,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S6
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -154,13 +215,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S7
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -175,55 +246,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S8
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		41, // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S9
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
+		54, // Expr5
+		11, // Expr6
+		13, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
+		21, // BoolLit
+		24, // NilLit
+		17, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		18, // ArrayLit
+		-1, // Elements
+		19, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S10
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -238,55 +339,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S11
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		43, // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S12
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
+		56, // Expr5
+		11, // Expr6
+		13, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
+		21, // BoolLit
+		24, // NilLit
+		17, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		18, // ArrayLit
+		-1, // Elements
+		19, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S13
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -301,34 +432,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S14
-		-1, // S'
-		-1, // Fscript
-		45, // Expr
-		47, // Expr1
-		48, // Expr2
-		49, // Expr3
-		50, // Expr4
-		52, // Expr5
-		53, // Expr6
-		55, // PrimaryExpr
-		46, // TernaryExpr
-		60, // TernaryArgument
-		61, // BoolLit
-		64, // NilLit
-		59, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S15
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -341,15 +461,56 @@ var gotoTab = gotoTable{
 		-1, // BoolLit
 		-1, // NilLit
 		-1, // Literal
+		58, // Ref
+		60, // Indexer
+		61, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S15
+		-1, // S'
+		-1, // Fscript
+		65, // Expr
+		67, // Expr0
+		68, // Expr1
+		69, // Expr2
+		70, // Expr3
+		71, // Expr4
+		73, // Expr5
+		74, // Expr6
+		76, // PrimaryExpr
+		66, // TernaryExpr
+		83, // TernaryArgument
+		84, // BoolLit
+		87, // NilLit
+		80, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		81, // ArrayLit
+		-1, // Elements
+		82, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S16
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -362,15 +523,25 @@ var gotoTab = gotoTable{
 		-1, // BoolLit
 		-1, // NilLit
 		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
+		97, // Ref
+		60, // Indexer
+		61, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S17
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -385,13 +556,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S18
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -406,13 +587,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S19
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -427,13 +618,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S20
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -448,13 +649,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S21
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -469,13 +680,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S22
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -490,13 +711,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S23
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -511,13 +742,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S24
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -532,13 +773,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S25
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -553,13 +804,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S26
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -574,13 +835,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 	gotoRow{ // S27
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -593,288 +864,87 @@ var gotoTab = gotoTable{
 		-1, // BoolLit
 		-1, // NilLit
 		-1, // Literal
-		73, // Ref
-		75, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S28
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		77, // Expr1
-		5,  // Expr2
-		6,  // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S29
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		78, // Expr2
-		6,  // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S30
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		79, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S31
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		80, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S32
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		81, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S33
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		82, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S34
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		83, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S35
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		84, // Expr3
-		7,  // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S36
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		85, // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S37
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		86, // Expr4
-		9,  // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S38
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		87, // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S39
+	gotoRow{ // S28
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		88, // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S40
+	gotoRow{ // S29
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
 		-1, // Expr4
-		89, // Expr5
-		10, // Expr6
-		12, // PrimaryExpr
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
 		-1, // TernaryExpr
 		-1, // TernaryArgument
-		18, // BoolLit
-		21, // NilLit
-		16, // Literal
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S41
+	gotoRow{ // S30
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -887,82 +957,16831 @@ var gotoTab = gotoTable{
 		-1, // BoolLit
 		-1, // NilLit
 		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
+		99, // Ref
+		60, // Indexer
+		61, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S42
+	gotoRow{ // S31
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		132, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S32
 		-1,  // S'
 		-1,  // Fscript
-		90,  // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		137, // Fields
+		138, // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S43
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S44
+	gotoRow{ // S33
 		-1,  // S'
 		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
+		-1,  // Expr
+		139, // Expr0
+		5,   // Expr1
+		6,   // Expr2
+		7,   // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
 		-1,  // Ref
 		-1,  // Indexer
-		126, // Args
-		139, // ExprList
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S45
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
+	gotoRow{ // S34
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		140, // Expr1
+		6,   // Expr2
+		7,   // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S35
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		141, // Expr2
+		7,   // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S36
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		142, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S37
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		143, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S38
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		144, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S39
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		145, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S40
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		146, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S41
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		147, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S42
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		148, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S43
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		149, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S44
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		150, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S45
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S46
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		152, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S47
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		153, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S48
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		154, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S49
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		155, // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S50
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		156, // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S51
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		157, // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S52
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		158, // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S53
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		159, // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S54
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S55
+		-1,  // S'
+		-1,  // Fscript
+		160, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S56
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S57
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		202, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S58
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		223, // Indexer
+		224, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S59
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S60
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S61
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S62
+		-1,  // S'
+		225, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S63
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S64
+		-1,  // S'
+		257, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S65
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S66
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S67
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S68
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S69
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S70
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S71
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S72
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		282, // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S73
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S74
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S75
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		284, // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S76
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S77
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		286, // Ref
+		288, // Indexer
+		289, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S78
+		-1,  // S'
+		-1,  // Fscript
+		293, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		66,  // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S79
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		295, // Ref
+		288, // Indexer
+		289, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S80
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S81
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S82
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S83
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S84
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S85
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S86
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S87
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S88
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S89
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S90
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S91
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S92
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S93
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		297, // Ref
+		288, // Indexer
+		289, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S94
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		299, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S95
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		301, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S96
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		302, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S97
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		223, // Indexer
+		224, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S98
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		321, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		223, // Indexer
+		224, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S100
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S101
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S102
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S108
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		355, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S109
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S110
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		357, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S113
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		359, // Ref
+		361, // Indexer
+		362, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S114
+		-1,  // S'
+		-1,  // Fscript
+		366, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		66,  // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S115
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		368, // Ref
+		361, // Indexer
+		362, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S116
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S118
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S122
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S123
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S124
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S127
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S129
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		370, // Ref
+		361, // Indexer
+		362, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S130
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		372, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S133
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		376, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S150
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		381, // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S156
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S166
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		403, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S169
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		404, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S171
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		406, // Ref
+		408, // Indexer
+		409, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S172
+		-1,  // S'
+		-1,  // Fscript
+		413, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S173
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		415, // Ref
+		408, // Indexer
+		409, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S178
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S186
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		416, // Ref
+		408, // Indexer
+		409, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S187
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		418, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S188
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		420, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S191
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S195
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		442, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S198
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		445, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		447, // Ref
+		449, // Indexer
+		450, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S201
+		-1,  // S'
+		-1,  // Fscript
+		455, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		458, // LambdaParams
+	},
+	gotoRow{ // S202
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S203
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		461, // Ref
+		449, // Indexer
+		450, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S204
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S205
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S206
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S207
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S210
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S216
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		462, // Ref
+		449, // Indexer
+		450, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S217
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		464, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S218
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		466, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S224
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S232
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		490, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S236
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		492, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S238
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S239
+		-1,  // S'
+		-1,  // Fscript
+		502, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		66,  // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S240
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		504, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S254
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		506, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S255
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		508, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S256
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		510, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S258
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S259
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		512, // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S262
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		513, // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S263
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		514, // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S264
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		515, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S265
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		516, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		517, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S267
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		518, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S268
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		519, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S269
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		520, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S270
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		521, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S271
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		522, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S272
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		523, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S274
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		525, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S275
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		526, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S276
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		527, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S277
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		528, // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S278
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		529, // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S279
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		530, // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S280
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		531, // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S281
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		532, // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S283
+		-1,  // S'
+		-1,  // Fscript
+		533, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S285
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		534, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S286
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		536, // Indexer
+		537, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S290
+		-1,  // S'
+		538, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S292
+		-1,  // S'
+		540, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S294
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		542, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S295
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		536, // Indexer
+		537, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S296
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		543, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S297
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		536, // Indexer
+		537, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S304
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S307
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S308
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S310
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		568, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S313
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		570, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S315
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		572, // Ref
+		574, // Indexer
+		575, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S316
+		-1,  // S'
+		-1,  // Fscript
+		579, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		580, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S317
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		582, // Ref
+		574, // Indexer
+		575, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S330
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S331
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		585, // Ref
+		574, // Indexer
+		575, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S332
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		587, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S333
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		589, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S334
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		590, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S335
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		591, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S336
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		592, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S337
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		593, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S338
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		594, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S339
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		595, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S340
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		596, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S341
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		597, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S342
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		598, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S343
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		599, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S344
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		600, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S345
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		601, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S346
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S347
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		603, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S348
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		604, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S349
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		605, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S350
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		606, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S351
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		607, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S352
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		608, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S353
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		609, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S354
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		610, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S356
+		-1,  // S'
+		-1,  // Fscript
+		611, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S358
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		612, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S359
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		614, // Indexer
+		615, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S363
+		-1,  // S'
+		616, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S365
+		-1,  // S'
+		618, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S366
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S367
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		620, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S368
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		614, // Indexer
+		615, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S369
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		621, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S370
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		614, // Indexer
+		615, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S374
+		-1,  // S'
+		623, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S377
+		-1,  // S'
+		625, // Fscript
+		626, // Expr
+		628, // Expr0
+		629, // Expr1
+		630, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		627, // TernaryExpr
+		644, // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S378
+		-1,  // S'
+		657, // Fscript
+		626, // Expr
+		628, // Expr0
+		629, // Expr1
+		630, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		627, // TernaryExpr
+		644, // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S379
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		658, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S381
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S382
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		659, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S383
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		660, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S384
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		661, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S385
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		662, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S386
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		663, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S387
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		664, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S388
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		665, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S389
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		666, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S390
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		667, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S391
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		668, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S392
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		669, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S393
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		670, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S394
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S395
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		672, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S396
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		673, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S397
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		674, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S398
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		675, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S399
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		676, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S400
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		677, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S401
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		678, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S402
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		679, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S403
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S404
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S405
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		680, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S406
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		682, // Indexer
+		683, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S407
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S408
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S409
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S410
+		-1,  // S'
+		684, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S411
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S412
+		-1,  // S'
+		686, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S414
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		688, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S415
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		682, // Indexer
+		683, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S416
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		682, // Indexer
+		683, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S417
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S418
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S419
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S421
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		691, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S422
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		692, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S423
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		693, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S424
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		694, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S425
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		695, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S426
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		696, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S427
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		697, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S428
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		698, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S429
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		699, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S430
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		700, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S431
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		701, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S432
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		702, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S433
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S434
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		704, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S435
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		705, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S436
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		706, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S437
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		707, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S438
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		708, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S439
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		709, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S440
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		710, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S441
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		711, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S443
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		447, // Ref
+		449, // Indexer
+		450, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S444
+		-1,  // S'
+		-1,  // Fscript
+		455, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S445
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S446
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		712, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S447
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		714, // Indexer
+		715, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S448
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S449
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S450
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S451
+		-1,  // S'
+		716, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S452
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S453
+		-1,  // S'
+		718, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S454
+		-1,  // S'
+		-1,  // Fscript
+		719, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S455
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S456
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		406, // Ref
+		408, // Indexer
+		409, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S457
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S458
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S459
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S460
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		725, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S461
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		714, // Indexer
+		715, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S462
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		714, // Indexer
+		715, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S463
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S464
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S466
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S467
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		728, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S468
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S469
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		729, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S470
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		730, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S471
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		731, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S472
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		732, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S473
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		733, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S474
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		734, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S475
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		735, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S476
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		736, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S477
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		737, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S478
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		738, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S479
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		739, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S480
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		740, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S481
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S482
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		742, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S483
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		743, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S484
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		744, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S485
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		745, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S486
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		746, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S487
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		747, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S488
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		748, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S489
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		749, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S491
+		-1,  // S'
+		-1,  // Fscript
+		750, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S492
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S493
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		751, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S494
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		753, // Indexer
+		754, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S495
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S496
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S497
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S498
+		-1,  // S'
+		755, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S499
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S500
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S501
+		-1,  // S'
+		757, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S502
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S503
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		759, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S504
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		753, // Indexer
+		754, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S505
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		760, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S506
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		753, // Indexer
+		754, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S507
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S508
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S509
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S510
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S511
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S512
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S513
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S514
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S515
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S516
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S517
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S518
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S519
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S520
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S521
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S522
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S523
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S524
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		763, // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S525
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S526
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S527
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S528
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S529
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S530
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S531
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S532
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S533
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S534
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S535
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S536
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S537
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S538
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S539
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S540
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S541
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S542
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S543
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S544
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S545
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S546
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S547
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		770, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S548
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		771, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S549
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		772, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S550
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		773, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S551
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		774, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S552
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		775, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S553
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		776, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S554
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		777, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S555
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		778, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S556
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		779, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S557
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		780, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S558
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		781, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S559
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S560
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		783, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S561
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		784, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S562
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		785, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S563
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		786, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S564
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		787, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S565
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		788, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S566
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		789, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S567
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		790, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S568
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S569
+		-1,  // S'
+		-1,  // Fscript
+		791, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S570
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S571
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		792, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S572
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		794, // Indexer
+		795, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S573
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
 		-1, // Expr5
 		-1, // Expr6
 		-1, // PrimaryExpr
@@ -973,13 +17792,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S46
+	gotoRow{ // S574
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -994,13 +17823,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S47
+	gotoRow{ // S575
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1015,13 +17854,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S48
+	gotoRow{ // S576
+		-1,  // S'
+		796, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S577
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1036,13 +17916,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S49
+	gotoRow{ // S578
+		-1,  // S'
+		798, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S579
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1057,13 +17978,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S50
+	gotoRow{ // S580
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1078,34 +18009,178 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S51
+	gotoRow{ // S581
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		801, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S582
 		-1,  // S'
 		-1,  // Fscript
 		-1,  // Expr
+		-1,  // Expr0
 		-1,  // Expr1
 		-1,  // Expr2
 		-1,  // Expr3
 		-1,  // Expr4
-		155, // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		794, // Indexer
+		795, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S583
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		802, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S52
+	gotoRow{ // S584
+		-1,  // S'
+		-1,  // Fscript
+		803, // Expr
+		4,   // Expr0
+		5,   // Expr1
+		6,   // Expr2
+		7,   // Expr3
+		8,   // Expr4
+		10,  // Expr5
+		11,  // Expr6
+		13,  // PrimaryExpr
+		804, // TernaryExpr
+		806, // TernaryArgument
+		21,  // BoolLit
+		24,  // NilLit
+		17,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		18,  // ArrayLit
+		-1,  // Elements
+		19,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S585
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		794, // Indexer
+		795, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S586
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1120,13 +18195,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S53
+	gotoRow{ // S587
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1141,34 +18226,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S54
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		157, // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S55
+	gotoRow{ // S588
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1183,13 +18257,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S56
+	gotoRow{ // S589
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1204,34 +18288,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S57
-		-1,  // S'
-		-1,  // Fscript
-		159, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		46,  // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S58
+	gotoRow{ // S590
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1246,13 +18319,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S59
+	gotoRow{ // S591
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1267,13 +18350,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S60
+	gotoRow{ // S592
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1288,13 +18381,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S61
+	gotoRow{ // S593
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1309,13 +18412,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S62
+	gotoRow{ // S594
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1330,13 +18443,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S63
+	gotoRow{ // S595
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1351,13 +18474,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S64
+	gotoRow{ // S596
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1372,13 +18505,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S65
+	gotoRow{ // S597
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1393,13 +18536,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S66
+	gotoRow{ // S598
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1414,13 +18567,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S67
+	gotoRow{ // S599
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1435,13 +18598,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S68
+	gotoRow{ // S600
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1456,13 +18629,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S69
+	gotoRow{ // S601
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1477,97 +18660,209 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S70
+	gotoRow{ // S602
 		-1,  // S'
 		-1,  // Fscript
 		-1,  // Expr
+		-1,  // Expr0
 		-1,  // Expr1
 		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		162, // Ref
-		164, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S71
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
+		809, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		166, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S72
-		-1,  // S'
-		-1,  // Fscript
-		167, // Expr
-		169, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		168, // TernaryExpr
-		182, // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S73
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		-1,  // Ref
-		194, // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S603
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S604
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S605
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S606
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S607
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S74
+	gotoRow{ // S608
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1582,13 +18877,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S75
+	gotoRow{ // S609
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1603,34 +18908,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S76
-		-1,  // S'
-		195, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S610
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S77
+	gotoRow{ // S611
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1645,13 +18970,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S78
+	gotoRow{ // S612
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1666,13 +19001,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S79
+	gotoRow{ // S613
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1687,13 +19032,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S80
+	gotoRow{ // S614
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1708,13 +19063,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S81
+	gotoRow{ // S615
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1729,13 +19094,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S82
+	gotoRow{ // S616
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1750,13 +19125,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S83
+	gotoRow{ // S617
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S618
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1771,13 +19187,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S84
+	gotoRow{ // S619
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1792,13 +19218,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S85
+	gotoRow{ // S620
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1813,13 +19249,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S86
+	gotoRow{ // S621
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1834,13 +19280,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S87
+	gotoRow{ // S622
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1855,13 +19311,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S88
+	gotoRow{ // S623
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1876,13 +19342,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S89
+	gotoRow{ // S624
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1897,13 +19373,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S90
+	gotoRow{ // S625
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1918,13 +19404,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S91
+	gotoRow{ // S626
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1939,13 +19435,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S92
+	gotoRow{ // S627
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1960,13 +19466,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S93
+	gotoRow{ // S628
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -1981,13 +19497,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S94
+	gotoRow{ // S629
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2002,34 +19528,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S95
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		235, // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S630
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S96
+	gotoRow{ // S631
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2044,13 +19590,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S97
+	gotoRow{ // S632
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2065,34 +19621,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S98
+	gotoRow{ // S633
 		-1,  // S'
 		-1,  // Fscript
 		-1,  // Expr
+		-1,  // Expr0
 		-1,  // Expr1
 		-1,  // Expr2
 		-1,  // Expr3
 		-1,  // Expr4
-		236, // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
+		837, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S99
+	gotoRow{ // S634
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2107,13 +19683,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S100
+	gotoRow{ // S635
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2128,34 +19714,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S101
+	gotoRow{ // S636
 		-1,  // S'
 		-1,  // Fscript
-		238, // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		839, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S102
+	gotoRow{ // S637
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2170,13 +19776,116 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S103
+	gotoRow{ // S638
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		841, // Ref
+		843, // Indexer
+		844, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S639
+		-1,  // S'
+		-1,  // Fscript
+		848, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		66,  // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S640
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		850, // Ref
+		843, // Indexer
+		844, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S641
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2191,13 +19900,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S104
+	gotoRow{ // S642
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2212,13 +19931,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S105
+	gotoRow{ // S643
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2233,13 +19962,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S106
+	gotoRow{ // S644
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2254,13 +19993,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S107
+	gotoRow{ // S645
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2275,13 +20024,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S108
+	gotoRow{ // S646
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2296,13 +20055,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S109
+	gotoRow{ // S647
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2317,13 +20086,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S110
+	gotoRow{ // S648
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2338,13 +20117,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S111
+	gotoRow{ // S649
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2359,13 +20148,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S112
+	gotoRow{ // S650
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2380,34 +20179,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S113
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		240, // Ref
-		242, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S114
+	gotoRow{ // S651
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2422,13 +20210,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S115
+	gotoRow{ // S652
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2443,13 +20241,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S116
+	gotoRow{ // S653
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2464,13 +20272,116 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S117
+	gotoRow{ // S654
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		852, // Ref
+		843, // Indexer
+		844, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S655
+		-1,  // S'
+		100, // Fscript
+		101, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		102, // TernaryExpr
+		119, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		854, // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S656
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		856, // Fields
+		138, // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S657
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2485,13 +20396,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S118
+	gotoRow{ // S658
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2506,34 +20427,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S119
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		257, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S659
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S120
+	gotoRow{ // S660
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2548,13 +20489,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S121
+	gotoRow{ // S661
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2569,34 +20520,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S122
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		258, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S123
+	gotoRow{ // S662
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2611,13 +20551,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S124
+	gotoRow{ // S663
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2632,34 +20582,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S125
-		-1,  // S'
-		-1,  // Fscript
-		260, // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S126
+	gotoRow{ // S664
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2674,13 +20613,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S127
+	gotoRow{ // S665
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2695,13 +20644,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S128
+	gotoRow{ // S666
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2716,13 +20675,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S129
+	gotoRow{ // S667
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2737,13 +20706,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S130
+	gotoRow{ // S668
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2758,13 +20737,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S131
+	gotoRow{ // S669
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2779,13 +20768,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S132
+	gotoRow{ // S670
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2800,13 +20799,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S133
+	gotoRow{ // S671
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		857, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S672
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2821,13 +20861,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S134
+	gotoRow{ // S673
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2842,13 +20892,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S135
+	gotoRow{ // S674
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2863,13 +20923,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S136
+	gotoRow{ // S675
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2884,13 +20954,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S137
+	gotoRow{ // S676
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2905,34 +20985,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S138
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		263, // Ref
-		265, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S139
+	gotoRow{ // S677
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2947,34 +21016,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S140
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		268, // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S141
+	gotoRow{ // S678
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -2989,13 +21047,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S142
+	gotoRow{ // S679
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3010,265 +21078,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S143
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		269, // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S144
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		270, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S145
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		271, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S146
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		272, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S147
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		273, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S148
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		274, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S149
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		275, // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S150
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		276, // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S151
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		277, // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S152
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		278, // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S153
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		279, // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S154
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		280, // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S155
+	gotoRow{ // S680
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3283,34 +21109,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S156
-		-1,  // S'
-		-1,  // Fscript
-		281, // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S157
+	gotoRow{ // S681
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3325,34 +21140,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S158
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		282, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S159
+	gotoRow{ // S682
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3367,55 +21171,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S160
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		284, // Args
-		139, // ExprList
+	gotoRow{ // S683
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S161
-		-1,  // S'
-		-1,  // Fscript
-		167, // Expr
-		169, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		168, // TernaryExpr
-		285, // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S684
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S162
+	gotoRow{ // S685
 		-1,  // S'
 		-1,  // Fscript
 		-1,  // Expr
+		-1,  // Expr0
 		-1,  // Expr1
 		-1,  // Expr2
 		-1,  // Expr3
@@ -3428,15 +21262,25 @@ var gotoTab = gotoTable{
 		-1,  // BoolLit
 		-1,  // NilLit
 		-1,  // Literal
-		-1,  // Ref
-		287, // Indexer
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S163
+	gotoRow{ // S686
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3451,13 +21295,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S164
+	gotoRow{ // S687
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3472,34 +21326,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S165
-		-1,  // S'
-		288, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S166
+	gotoRow{ // S688
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3514,13 +21357,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S167
+	gotoRow{ // S689
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3535,13 +21388,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S168
+	gotoRow{ // S690
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3556,13 +21419,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S169
+	gotoRow{ // S691
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3577,13 +21450,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S170
+	gotoRow{ // S692
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3598,13 +21481,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S171
+	gotoRow{ // S693
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3619,13 +21512,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S172
+	gotoRow{ // S694
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3640,34 +21543,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S173
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		304, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S174
+	gotoRow{ // S695
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3682,13 +21574,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S175
+	gotoRow{ // S696
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3703,34 +21605,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S176
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		306, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S177
+	gotoRow{ // S697
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3745,13 +21636,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S178
+	gotoRow{ // S698
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3766,34 +21667,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S179
-		-1,  // S'
-		-1,  // Fscript
-		308, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		309, // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S180
+	gotoRow{ // S699
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3808,13 +21698,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S181
+	gotoRow{ // S700
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3829,13 +21729,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S182
+	gotoRow{ // S701
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3850,13 +21760,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S183
+	gotoRow{ // S702
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3871,13 +21791,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S184
+	gotoRow{ // S703
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		863, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S704
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3892,13 +21853,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S185
+	gotoRow{ // S705
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3913,13 +21884,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S186
+	gotoRow{ // S706
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3934,13 +21915,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S187
+	gotoRow{ // S707
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3955,13 +21946,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S188
+	gotoRow{ // S708
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3976,13 +21977,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S189
+	gotoRow{ // S709
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -3997,13 +22008,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S190
+	gotoRow{ // S710
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4018,13 +22039,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S191
+	gotoRow{ // S711
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4039,34 +22070,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S192
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		313, // Ref
-		315, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S193
+	gotoRow{ // S712
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4081,13 +22101,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S194
+	gotoRow{ // S713
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4102,13 +22132,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S195
+	gotoRow{ // S714
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4123,13 +22163,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S196
+	gotoRow{ // S715
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4144,13 +22194,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S197
+	gotoRow{ // S716
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4165,13 +22225,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S198
+	gotoRow{ // S717
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S718
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4186,13 +22287,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S199
+	gotoRow{ // S719
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4207,13 +22318,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S200
+	gotoRow{ // S720
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4228,13 +22349,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S201
+	gotoRow{ // S721
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4249,34 +22380,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S202
+	gotoRow{ // S722
 		-1,  // S'
 		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		331, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
+		869, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S203
+	gotoRow{ // S723
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4291,13 +22442,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S204
+	gotoRow{ // S724
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4312,34 +22473,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S205
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		334, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S725
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S726
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S206
+	gotoRow{ // S727
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4354,13 +22566,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S207
+	gotoRow{ // S728
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4375,34 +22597,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S208
-		-1,  // S'
-		-1,  // Fscript
-		337, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		46,  // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S209
+	gotoRow{ // S729
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4417,13 +22628,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S210
+	gotoRow{ // S730
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4438,13 +22659,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S211
+	gotoRow{ // S731
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4459,13 +22690,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S212
+	gotoRow{ // S732
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4480,13 +22721,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S213
+	gotoRow{ // S733
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4501,13 +22752,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S214
+	gotoRow{ // S734
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4522,13 +22783,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S215
+	gotoRow{ // S735
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4543,13 +22814,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S216
+	gotoRow{ // S736
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4564,13 +22845,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S217
+	gotoRow{ // S737
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4585,13 +22876,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S218
+	gotoRow{ // S738
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4606,13 +22907,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S219
+	gotoRow{ // S739
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4627,13 +22938,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S220
+	gotoRow{ // S740
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4648,307 +22969,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S221
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		340, // Ref
-		342, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S222
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		344, // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S223
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		345, // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S224
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		346, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S225
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		347, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S226
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		348, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S227
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		349, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S228
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		350, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S229
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		351, // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S230
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		352, // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S231
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		353, // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S232
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		354, // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S233
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		355, // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S234
+	gotoRow{ // S741
 		-1,  // S'
 		-1,  // Fscript
 		-1,  // Expr
+		-1,  // Expr0
 		-1,  // Expr1
 		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		356, // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
+		873, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S235
+	gotoRow{ // S742
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4963,13 +23031,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S236
+	gotoRow{ // S743
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -4984,34 +23062,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S237
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		357, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S238
+	gotoRow{ // S744
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5024,57 +23091,25 @@ var gotoTab = gotoTable{
 		-1, // BoolLit
 		-1, // NilLit
 		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
-	},
-	gotoRow{ // S239
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		359, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S240
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		-1,  // Ref
-		361, // Indexer
-		-1,  // Args
-		-1,  // ExprList
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S241
+	gotoRow{ // S745
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5089,13 +23124,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S242
+	gotoRow{ // S746
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5110,307 +23155,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S243
-		-1,  // S'
-		362, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S244
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		364, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S245
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		365, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S246
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		366, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S247
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		367, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S248
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		368, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S249
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		369, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S250
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		370, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S251
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		371, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S252
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		372, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S253
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		373, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S254
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		374, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S255
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		375, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S256
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		376, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S257
+	gotoRow{ // S747
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5425,13 +23186,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S258
+	gotoRow{ // S748
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5446,34 +23217,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S259
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		377, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S260
+	gotoRow{ // S749
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5488,13 +23248,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S261
+	gotoRow{ // S750
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5509,55 +23279,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S262
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		379, // Args
-		139, // ExprList
+	gotoRow{ // S751
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S263
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		-1,  // Ref
-		381, // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S752
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S264
+	gotoRow{ // S753
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5572,13 +23372,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S265
+	gotoRow{ // S754
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5593,55 +23403,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S266
-		-1,  // S'
-		382, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S267
-		-1,  // S'
-		-1,  // Fscript
-		384, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S268
+	gotoRow{ // S755
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5656,13 +23434,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S269
+	gotoRow{ // S756
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S757
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5677,13 +23496,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S270
+	gotoRow{ // S758
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5698,13 +23527,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S271
+	gotoRow{ // S759
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5719,13 +23558,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S272
+	gotoRow{ // S760
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5740,13 +23589,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S273
+	gotoRow{ // S761
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5761,13 +23620,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S274
+	gotoRow{ // S762
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5782,13 +23651,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S275
+	gotoRow{ // S763
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5803,13 +23682,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S276
+	gotoRow{ // S764
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5824,13 +23713,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S277
+	gotoRow{ // S765
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5845,13 +23744,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S278
+	gotoRow{ // S766
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5866,13 +23775,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S279
+	gotoRow{ // S767
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5887,13 +23806,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S280
+	gotoRow{ // S768
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5908,13 +23837,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S281
+	gotoRow{ // S769
+		-1,  // S'
+		-1,  // Fscript
+		880, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		881, // TernaryExpr
+		883, // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S770
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5929,13 +23899,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S282
+	gotoRow{ // S771
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5950,13 +23930,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S283
+	gotoRow{ // S772
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5971,13 +23961,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S284
+	gotoRow{ // S773
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -5992,13 +23992,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S285
+	gotoRow{ // S774
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6013,13 +24023,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S286
+	gotoRow{ // S775
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6034,13 +24054,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S287
+	gotoRow{ // S776
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6055,13 +24085,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S288
+	gotoRow{ // S777
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6076,13 +24116,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S289
+	gotoRow{ // S778
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6097,13 +24147,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S290
+	gotoRow{ // S779
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6118,286 +24178,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S291
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		390, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S292
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		391, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S293
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		392, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S294
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		393, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S295
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		394, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S296
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		395, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S297
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		396, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S298
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		397, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S299
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		398, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S300
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		399, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S301
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		400, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S302
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		401, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S303
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		402, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S780
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S304
+	gotoRow{ // S781
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6412,34 +24240,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S305
+	gotoRow{ // S782
 		-1,  // S'
 		-1,  // Fscript
-		403, // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		884, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
 		-1,  // TernaryExpr
 		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S306
+	gotoRow{ // S783
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6454,34 +24302,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S307
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		404, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S308
+	gotoRow{ // S784
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6496,13 +24333,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S309
+	gotoRow{ // S785
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6517,97 +24364,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S310
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		407, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S311
-		-1,  // S'
-		-1,  // Fscript
-		167, // Expr
-		169, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		168, // TernaryExpr
-		408, // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S312
-		-1,  // S'
-		-1,  // Fscript
-		409, // Expr
-		4,   // Expr1
-		5,   // Expr2
-		6,   // Expr3
-		7,   // Expr4
-		9,   // Expr5
-		10,  // Expr6
-		12,  // PrimaryExpr
-		410, // TernaryExpr
-		412, // TernaryArgument
-		18,  // BoolLit
-		21,  // NilLit
-		16,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S313
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		-1,  // Ref
-		414, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S314
+	gotoRow{ // S786
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6622,13 +24395,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S315
+	gotoRow{ // S787
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6643,34 +24426,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S316
-		-1,  // S'
-		415, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S317
+	gotoRow{ // S788
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6685,286 +24457,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S318
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		417, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S319
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		418, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S320
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		419, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S321
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		420, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S322
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		421, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S323
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		422, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S324
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		423, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S325
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		424, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S326
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		425, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S327
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		426, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S328
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		427, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S329
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		428, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S330
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		429, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S789
+		-1, // S'
+		-1, // Fscript
+		-1, // Expr
+		-1, // Expr0
+		-1, // Expr1
+		-1, // Expr2
+		-1, // Expr3
+		-1, // Expr4
+		-1, // Expr5
+		-1, // Expr6
+		-1, // PrimaryExpr
+		-1, // TernaryExpr
+		-1, // TernaryArgument
+		-1, // BoolLit
+		-1, // NilLit
+		-1, // Literal
+		-1, // Ref
+		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
+		-1, // Args
+		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S331
+	gotoRow{ // S790
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -6979,13 +24519,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S332
+	gotoRow{ // S791
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7000,34 +24550,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S333
-		-1,  // S'
-		-1,  // Fscript
-		430, // Expr
-		91,  // Expr1
-		92,  // Expr2
-		93,  // Expr3
-		94,  // Expr4
-		96,  // Expr5
-		97,  // Expr6
-		99,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		104, // BoolLit
-		107, // NilLit
-		103, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S334
+	gotoRow{ // S792
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7042,34 +24581,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S335
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		431, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S336
+	gotoRow{ // S793
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7084,13 +24612,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S337
+	gotoRow{ // S794
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7105,76 +24643,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S338
-		-1,  // S'
-		-1,  // Fscript
-		114, // Expr
-		115, // Expr1
-		116, // Expr2
-		117, // Expr3
-		118, // Expr4
-		120, // Expr5
-		121, // Expr6
-		123, // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		129, // BoolLit
-		132, // NilLit
-		128, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		433, // Args
-		139, // ExprList
-	},
-	gotoRow{ // S339
-		-1,  // S'
-		-1,  // Fscript
-		167, // Expr
-		169, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		168, // TernaryExpr
-		434, // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S340
-		-1,  // S'
-		-1,  // Fscript
-		-1,  // Expr
-		-1,  // Expr1
-		-1,  // Expr2
-		-1,  // Expr3
-		-1,  // Expr4
-		-1,  // Expr5
-		-1,  // Expr6
-		-1,  // PrimaryExpr
-		-1,  // TernaryExpr
-		-1,  // TernaryArgument
-		-1,  // BoolLit
-		-1,  // NilLit
-		-1,  // Literal
-		-1,  // Ref
-		436, // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S341
+	gotoRow{ // S795
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7189,13 +24674,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S342
+	gotoRow{ // S796
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7210,34 +24705,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S343
+	gotoRow{ // S797
 		-1,  // S'
-		437, // Fscript
-		196, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		197, // TernaryExpr
-		211, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S344
+	gotoRow{ // S798
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7252,13 +24767,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S345
+	gotoRow{ // S799
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7273,13 +24798,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S346
+	gotoRow{ // S800
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7294,13 +24829,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S347
+	gotoRow{ // S801
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7315,13 +24860,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S348
+	gotoRow{ // S802
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7336,13 +24891,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S349
+	gotoRow{ // S803
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7357,13 +24922,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S350
+	gotoRow{ // S804
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7378,13 +24953,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S351
+	gotoRow{ // S805
+		-1,  // S'
+		-1,  // Fscript
+		65,  // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		891, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S806
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7399,13 +25015,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S352
+	gotoRow{ // S807
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7420,13 +25046,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S353
+	gotoRow{ // S808
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7441,13 +25077,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S354
+	gotoRow{ // S809
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7462,13 +25108,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S355
+	gotoRow{ // S810
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7483,13 +25139,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S356
+	gotoRow{ // S811
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7504,13 +25170,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S357
+	gotoRow{ // S812
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7525,13 +25201,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S358
+	gotoRow{ // S813
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7546,13 +25232,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S359
+	gotoRow{ // S814
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7567,13 +25263,426 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S815
+		-1,  // S'
+		-1,  // Fscript
+		892, // Expr
+		103, // Expr0
+		104, // Expr1
+		105, // Expr2
+		106, // Expr3
+		107, // Expr4
+		109, // Expr5
+		110, // Expr6
+		112, // PrimaryExpr
+		893, // TernaryExpr
+		895, // TernaryArgument
+		120, // BoolLit
+		123, // NilLit
+		116, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		117, // ArrayLit
+		-1,  // Elements
+		118, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S816
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		896, // Expr0
+		629, // Expr1
+		630, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S817
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		897, // Expr1
+		630, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S818
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		898, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S819
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		899, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S820
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		900, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S821
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		901, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S822
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		902, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S823
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		903, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S824
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		904, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S825
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		905, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S826
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		906, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S827
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		907, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S360
+	gotoRow{ // S828
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7588,118 +25697,271 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S361
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S829
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		909, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S362
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S830
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		910, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S363
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S831
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		911, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S364
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S832
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		912, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S365
-		-1, // S'
-		-1, // Fscript
-		-1, // Expr
-		-1, // Expr1
-		-1, // Expr2
-		-1, // Expr3
-		-1, // Expr4
-		-1, // Expr5
-		-1, // Expr6
-		-1, // PrimaryExpr
-		-1, // TernaryExpr
-		-1, // TernaryArgument
-		-1, // BoolLit
-		-1, // NilLit
-		-1, // Literal
-		-1, // Ref
-		-1, // Indexer
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S833
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		913, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S366
+	gotoRow{ // S834
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		914, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S835
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		915, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S836
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		916, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S837
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7714,13 +25976,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S367
+	gotoRow{ // S838
+		-1,  // S'
+		-1,  // Fscript
+		917, // Expr
+		161, // Expr0
+		162, // Expr1
+		163, // Expr2
+		164, // Expr3
+		165, // Expr4
+		167, // Expr5
+		168, // Expr6
+		170, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		177, // BoolLit
+		180, // NilLit
+		174, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		175, // ArrayLit
+		-1,  // Elements
+		176, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S839
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7735,13 +26038,85 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S368
+	gotoRow{ // S840
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		918, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S841
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		920, // Indexer
+		921, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S842
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7756,13 +26131,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S369
+	gotoRow{ // S843
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7777,13 +26162,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S370
+	gotoRow{ // S844
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7798,13 +26193,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S371
+	gotoRow{ // S845
+		-1,  // S'
+		922, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S846
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7819,13 +26255,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
+	},
+	gotoRow{ // S847
+		-1,  // S'
+		924, // Fscript
+		226, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		227, // TernaryExpr
+		244, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S372
+	gotoRow{ // S848
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7840,13 +26317,147 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S373
+	gotoRow{ // S849
+		-1,  // S'
+		-1,  // Fscript
+		189, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		926, // Args
+		219, // ExprList
+		220, // Arg
+		221, // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S850
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		920, // Indexer
+		921, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S851
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		927, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S852
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		-1,  // Ref
+		920, // Indexer
+		921, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S853
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7861,13 +26472,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S374
+	gotoRow{ // S854
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7882,13 +26503,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S375
+	gotoRow{ // S855
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7903,13 +26534,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S376
+	gotoRow{ // S856
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7924,13 +26565,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S377
+	gotoRow{ // S857
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7945,13 +26596,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S378
+	gotoRow{ // S858
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7966,13 +26627,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S379
+	gotoRow{ // S859
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -7987,13 +26658,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S380
+	gotoRow{ // S860
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8008,13 +26689,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S381
+	gotoRow{ // S861
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8029,13 +26720,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S382
+	gotoRow{ // S862
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8050,13 +26751,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S383
+	gotoRow{ // S863
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8071,13 +26782,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S384
+	gotoRow{ // S864
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8092,13 +26813,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S385
+	gotoRow{ // S865
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8113,13 +26844,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S386
+	gotoRow{ // S866
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8134,34 +26875,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S387
-		-1,  // S'
-		-1,  // Fscript
-		447, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		448, // TernaryExpr
-		450, // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S388
+	gotoRow{ // S867
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8176,13 +26906,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S389
+	gotoRow{ // S868
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8197,13 +26937,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S390
+	gotoRow{ // S869
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8218,13 +26968,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S391
+	gotoRow{ // S870
+		-1,  // S'
+		-1,  // Fscript
+		930, // Expr
+		190, // Expr0
+		191, // Expr1
+		192, // Expr2
+		193, // Expr3
+		194, // Expr4
+		196, // Expr5
+		197, // Expr6
+		199, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		207, // BoolLit
+		210, // NilLit
+		204, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		205, // ArrayLit
+		-1,  // Elements
+		206, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S871
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8239,13 +27030,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S392
+	gotoRow{ // S872
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8260,13 +27061,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S393
+	gotoRow{ // S873
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8281,13 +27092,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S394
+	gotoRow{ // S874
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8302,13 +27123,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S395
+	gotoRow{ // S875
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8323,13 +27154,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S396
+	gotoRow{ // S876
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8344,13 +27185,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S397
+	gotoRow{ // S877
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8365,13 +27216,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S398
+	gotoRow{ // S878
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8386,13 +27247,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S399
+	gotoRow{ // S879
+		-1,  // S'
+		-1,  // Fscript
+		931, // Expr
+		228, // Expr0
+		229, // Expr1
+		230, // Expr2
+		231, // Expr3
+		232, // Expr4
+		234, // Expr5
+		235, // Expr6
+		237, // PrimaryExpr
+		932, // TernaryExpr
+		934, // TernaryArgument
+		245, // BoolLit
+		248, // NilLit
+		241, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		242, // ArrayLit
+		-1,  // Elements
+		243, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S880
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8407,13 +27309,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S400
+	gotoRow{ // S881
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8428,13 +27340,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S401
+	gotoRow{ // S882
+		-1,  // S'
+		-1,  // Fscript
+		293, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		935, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S883
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8449,13 +27402,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S402
+	gotoRow{ // S884
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8470,13 +27433,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S403
+	gotoRow{ // S885
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8491,13 +27464,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S404
+	gotoRow{ // S886
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8512,13 +27495,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S405
+	gotoRow{ // S887
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8533,13 +27526,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S406
+	gotoRow{ // S888
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8554,13 +27557,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S407
+	gotoRow{ // S889
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8575,13 +27588,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S408
+	gotoRow{ // S890
+		-1,  // S'
+		-1,  // Fscript
+		303, // Expr
+		305, // Expr0
+		306, // Expr1
+		307, // Expr2
+		308, // Expr3
+		309, // Expr4
+		311, // Expr5
+		312, // Expr6
+		314, // PrimaryExpr
+		304, // TernaryExpr
+		936, // TernaryArgument
+		322, // BoolLit
+		325, // NilLit
+		318, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		319, // ArrayLit
+		-1,  // Elements
+		320, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S891
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8596,13 +27650,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S409
+	gotoRow{ // S892
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8617,13 +27681,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S410
+	gotoRow{ // S893
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8638,34 +27712,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S411
+	gotoRow{ // S894
 		-1,  // S'
 		-1,  // Fscript
-		45,  // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		454, // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
+		366, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		938, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S412
+	gotoRow{ // S895
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8680,13 +27774,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S413
+	gotoRow{ // S896
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8701,13 +27805,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S414
+	gotoRow{ // S897
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8722,13 +27836,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S415
+	gotoRow{ // S898
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8743,13 +27867,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S416
+	gotoRow{ // S899
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8764,13 +27898,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S417
+	gotoRow{ // S900
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8785,13 +27929,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S418
+	gotoRow{ // S901
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8806,13 +27960,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S419
+	gotoRow{ // S902
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8827,13 +27991,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S420
+	gotoRow{ // S903
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8848,13 +28022,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S421
+	gotoRow{ // S904
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8869,13 +28053,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S422
+	gotoRow{ // S905
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8890,13 +28084,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S423
+	gotoRow{ // S906
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8911,13 +28115,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S424
+	gotoRow{ // S907
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8932,13 +28146,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S425
+	gotoRow{ // S908
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		939, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S909
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8953,13 +28208,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S426
+	gotoRow{ // S910
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8974,13 +28239,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S427
+	gotoRow{ // S911
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -8995,13 +28270,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S428
+	gotoRow{ // S912
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9016,13 +28301,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S429
+	gotoRow{ // S913
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9037,13 +28332,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S430
+	gotoRow{ // S914
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9058,13 +28363,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S431
+	gotoRow{ // S915
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9079,13 +28394,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S432
+	gotoRow{ // S916
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9100,13 +28425,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S433
+	gotoRow{ // S917
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9121,13 +28456,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S434
+	gotoRow{ // S918
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9142,13 +28487,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S435
+	gotoRow{ // S919
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9163,13 +28518,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S436
+	gotoRow{ // S920
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9184,13 +28549,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S437
+	gotoRow{ // S921
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9205,13 +28580,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S438
+	gotoRow{ // S922
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9226,13 +28611,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S439
+	gotoRow{ // S923
+		-1,  // S'
+		-1,  // Fscript
+		-1,  // Expr
+		-1,  // Expr0
+		-1,  // Expr1
+		-1,  // Expr2
+		-1,  // Expr3
+		-1,  // Expr4
+		-1,  // Expr5
+		-1,  // Expr6
+		-1,  // PrimaryExpr
+		-1,  // TernaryExpr
+		-1,  // TernaryArgument
+		-1,  // BoolLit
+		-1,  // NilLit
+		-1,  // Literal
+		494, // Ref
+		496, // Indexer
+		497, // SafeNav
+		-1,  // ArrayLit
+		-1,  // Elements
+		-1,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S924
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9247,13 +28673,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S440
+	gotoRow{ // S925
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9268,13 +28704,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S441
+	gotoRow{ // S926
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9289,13 +28735,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S442
+	gotoRow{ // S927
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9310,13 +28766,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S443
+	gotoRow{ // S928
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9331,13 +28797,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S444
+	gotoRow{ // S929
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9352,13 +28828,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S445
+	gotoRow{ // S930
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9373,13 +28859,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S446
+	gotoRow{ // S931
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9394,13 +28890,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S447
+	gotoRow{ // S932
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9415,13 +28921,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S448
+	gotoRow{ // S933
+		-1,  // S'
+		-1,  // Fscript
+		502, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		946, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S934
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9436,34 +28983,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S449
-		-1,  // S'
-		-1,  // Fscript
-		159, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		462, // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S450
+	gotoRow{ // S935
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9478,13 +29014,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S451
+	gotoRow{ // S936
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9499,13 +29045,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S452
+	gotoRow{ // S937
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9520,34 +29076,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S453
-		-1,  // S'
-		-1,  // Fscript
-		167, // Expr
-		169, // Expr1
-		170, // Expr2
-		171, // Expr3
-		172, // Expr4
-		174, // Expr5
-		175, // Expr6
-		177, // PrimaryExpr
-		168, // TernaryExpr
-		463, // TernaryArgument
-		183, // BoolLit
-		186, // NilLit
-		181, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S454
+	gotoRow{ // S938
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9562,13 +29107,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S455
+	gotoRow{ // S939
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9583,13 +29138,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S456
+	gotoRow{ // S940
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9604,13 +29169,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S457
+	gotoRow{ // S941
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9625,13 +29200,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S458
+	gotoRow{ // S942
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9646,34 +29231,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S459
-		-1,  // S'
-		-1,  // Fscript
-		465, // Expr
-		198, // Expr1
-		199, // Expr2
-		200, // Expr3
-		201, // Expr4
-		203, // Expr5
-		204, // Expr6
-		206, // PrimaryExpr
-		466, // TernaryExpr
-		468, // TernaryArgument
-		212, // BoolLit
-		215, // NilLit
-		210, // Literal
-		-1,  // Ref
-		-1,  // Indexer
-		-1,  // Args
-		-1,  // ExprList
-	},
-	gotoRow{ // S460
+	gotoRow{ // S943
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9688,13 +29262,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S461
+	gotoRow{ // S944
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9709,13 +29293,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S462
+	gotoRow{ // S945
+		-1,  // S'
+		-1,  // Fscript
+		949, // Expr
+		628, // Expr0
+		629, // Expr1
+		630, // Expr2
+		631, // Expr3
+		632, // Expr4
+		634, // Expr5
+		635, // Expr6
+		637, // PrimaryExpr
+		950, // TernaryExpr
+		952, // TernaryArgument
+		645, // BoolLit
+		648, // NilLit
+		641, // Literal
+		-1,  // Ref
+		-1,  // Indexer
+		-1,  // SafeNav
+		642, // ArrayLit
+		-1,  // Elements
+		643, // ObjectLit
+		-1,  // Fields
+		-1,  // Field
+		-1,  // Args
+		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
+	},
+	gotoRow{ // S946
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9730,13 +29355,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S463
+	gotoRow{ // S947
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9751,13 +29386,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S464
+	gotoRow{ // S948
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9772,13 +29417,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S465
+	gotoRow{ // S949
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9793,13 +29448,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S466
+	gotoRow{ // S950
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9814,34 +29479,54 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S467
+	gotoRow{ // S951
 		-1,  // S'
 		-1,  // Fscript
-		337, // Expr
-		47,  // Expr1
-		48,  // Expr2
-		49,  // Expr3
-		50,  // Expr4
-		52,  // Expr5
-		53,  // Expr6
-		55,  // PrimaryExpr
-		470, // TernaryExpr
-		60,  // TernaryArgument
-		61,  // BoolLit
-		64,  // NilLit
-		59,  // Literal
+		848, // Expr
+		67,  // Expr0
+		68,  // Expr1
+		69,  // Expr2
+		70,  // Expr3
+		71,  // Expr4
+		73,  // Expr5
+		74,  // Expr6
+		76,  // PrimaryExpr
+		954, // TernaryExpr
+		83,  // TernaryArgument
+		84,  // BoolLit
+		87,  // NilLit
+		80,  // Literal
 		-1,  // Ref
 		-1,  // Indexer
+		-1,  // SafeNav
+		81,  // ArrayLit
+		-1,  // Elements
+		82,  // ObjectLit
+		-1,  // Fields
+		-1,  // Field
 		-1,  // Args
 		-1,  // ExprList
+		-1,  // Arg
+		-1,  // Lambda
+		-1,  // LambdaParams
 	},
-	gotoRow{ // S468
+	gotoRow{ // S952
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9856,13 +29541,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S469
+	gotoRow{ // S953
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9877,13 +29572,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S470
+	gotoRow{ // S954
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9898,13 +29603,23 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
-	gotoRow{ // S471
+	gotoRow{ // S955
 		-1, // S'
 		-1, // Fscript
 		-1, // Expr
+		-1, // Expr0
 		-1, // Expr1
 		-1, // Expr2
 		-1, // Expr3
@@ -9919,7 +29634,16 @@ var gotoTab = gotoTable{
 		-1, // Literal
 		-1, // Ref
 		-1, // Indexer
+		-1, // SafeNav
+		-1, // ArrayLit
+		-1, // Elements
+		-1, // ObjectLit
+		-1, // Fields
+		-1, // Field
 		-1, // Args
 		-1, // ExprList
+		-1, // Arg
+		-1, // Lambda
+		-1, // LambdaParams
 	},
 }
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 53
-	numStates      = 472
-	numSymbols     = 54
+	numProductions = 89
+	numStates      = 956
+	numSymbols     = 77
 )
 
 // Stack
@@ -91,6 +91,7 @@ type Parser struct {
 	stack     *stack
 	nextToken *token.Token
 	pos       int
+	Context   Context
 }
 
 type Scanner interface {
@@ -202,7 +203,7 @@ func (p *Parser) Parse(scanner Scanner) (res interface{}, err error) {
 			p.nextToken = scanner.Scan()
 		case reduce:
 			prod := productionsTable[int(act)]
-			attrib, err := prod.ReduceFunc(p.stack.popN(prod.NumSymbols))
+			attrib, err := prod.ReduceFunc(p.stack.popN(prod.NumSymbols), p.Context)
 			if err != nil {
 				return nil, p.newError(err)
 			} else {
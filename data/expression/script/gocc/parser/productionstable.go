@@ -5,7 +5,6 @@ package parser
 import "github.com/project-flogo/core/data/expression/script/gocc/ast"
 
 type (
-	//TODO: change type and variable names to be consistent with other tables
 	ProdTab      [numProductions]ProdTabEntry
 	ProdTabEntry struct {
 		String     string
@@ -13,7 +12,7 @@ type (
 		NTType     int
 		Index      int
 		NumSymbols int
-		ReduceFunc func([]Attrib) (Attrib, error)
+		ReduceFunc func([]Attrib, interface{}) (Attrib, error)
 	}
 	Attrib interface {
 	}
@@ -26,7 +25,7 @@ var productionsTable = ProdTab{
 		NTType:     0,
 		Index:      0,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
@@ -36,7 +35,7 @@ var productionsTable = ProdTab{
 		NTType:     1,
 		Index:      1,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
@@ -46,508 +45,868 @@ var productionsTable = ProdTab{
 		NTType:     1,
 		Index:      2,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr : Expr "||" Expr1	<< ast.NewBoolExpr(X[0],X[1],X[2]) >>`,
+		String: `Expr : Expr "??" Expr0	<< ast.NewCoalesceExpr(X[0],X[2]) >>`,
 		Id:         "Expr",
 		NTType:     2,
 		Index:      3,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewBoolExpr(X[0],X[1],X[2])
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCoalesceExpr(X[0],X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr : Expr1	<<  >>`,
+		String: `Expr : Expr0	<<  >>`,
 		Id:         "Expr",
 		NTType:     2,
 		Index:      4,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr1 : Expr1 "&&" Expr2	<< ast.NewBoolExpr(X[0],X[1],X[2]) >>`,
-		Id:         "Expr1",
+		String: `Expr0 : Expr0 "||" Expr1	<< ast.NewBoolExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr0",
 		NTType:     3,
 		Index:      5,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewBoolExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr1 : Expr2	<<  >>`,
-		Id:         "Expr1",
+		String: `Expr0 : Expr1	<<  >>`,
+		Id:         "Expr0",
 		NTType:     3,
 		Index:      6,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr2 : Expr2 "==" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
-		Id:         "Expr2",
+		String: `Expr1 : Expr1 "&&" Expr2	<< ast.NewBoolExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr1",
 		NTType:     4,
 		Index:      7,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBoolExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr1 : Expr2	<<  >>`,
+		Id:         "Expr1",
+		NTType:     4,
+		Index:      8,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "==" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      9,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr2 "!=" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      8,
+		NTType:     5,
+		Index:      10,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr2 "<" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      9,
+		NTType:     5,
+		Index:      11,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr2 "<=" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      10,
+		NTType:     5,
+		Index:      12,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr2 ">" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      11,
+		NTType:     5,
+		Index:      13,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr2 ">=" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      12,
+		NTType:     5,
+		Index:      14,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "=~" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      15,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "!~" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      16,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "in" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      17,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "not" "in" Expr3	<< ast.NewNotInExpr(X[0],X[3]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      18,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNotInExpr(X[0],X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "contains" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      19,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "startsWith" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      20,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmpExpr(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2 : Expr2 "endsWith" Expr3	<< ast.NewCmpExpr(X[0],X[1],X[2]) >>`,
+		Id:         "Expr2",
+		NTType:     5,
+		Index:      21,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmpExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr2 : Expr3	<<  >>`,
 		Id:         "Expr2",
-		NTType:     4,
-		Index:      13,
+		NTType:     5,
+		Index:      22,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Expr3 : Expr3 "+" Expr4	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr3",
-		NTType:     5,
-		Index:      14,
+		NTType:     6,
+		Index:      23,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewArithExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr3 : Expr3 "-" Expr4	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr3",
-		NTType:     5,
-		Index:      15,
+		NTType:     6,
+		Index:      24,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewArithExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr3 : Expr4	<<  >>`,
 		Id:         "Expr3",
-		NTType:     5,
-		Index:      16,
+		NTType:     6,
+		Index:      25,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Expr4 : Expr4 "*" Expr5	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr4",
-		NTType:     6,
-		Index:      17,
+		NTType:     7,
+		Index:      26,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewArithExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr4 : Expr4 "/" Expr5	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr4",
-		NTType:     6,
-		Index:      18,
+		NTType:     7,
+		Index:      27,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewArithExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr4 : Expr4 "%!"(MISSING) Expr5	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
+		String: `Expr4 : Expr4 "%" Expr5	<< ast.NewArithExpr(X[0],X[1],X[2]) >>`,
 		Id:         "Expr4",
-		NTType:     6,
-		Index:      19,
+		NTType:     7,
+		Index:      28,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewArithExpr(X[0],X[1],X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr4 : Expr5	<<  >>`,
 		Id:         "Expr4",
-		NTType:     6,
-		Index:      20,
+		NTType:     7,
+		Index:      29,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Expr5 : Expr6	<<  >>`,
 		Id:         "Expr5",
-		NTType:     7,
-		Index:      21,
+		NTType:     8,
+		Index:      30,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Expr5 : "-" Expr5	<< ast.NewUnaryExpr(X[0], X[1]) >>`,
 		Id:         "Expr5",
-		NTType:     7,
-		Index:      22,
+		NTType:     8,
+		Index:      31,
 		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewUnaryExpr(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr5 : "!" Expr5	<< ast.NewUnaryExpr(X[0], X[1]) >>`,
 		Id:         "Expr5",
-		NTType:     7,
-		Index:      23,
+		NTType:     8,
+		Index:      32,
 		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewUnaryExpr(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr6 : PrimaryExpr	<<  >>`,
 		Id:         "Expr6",
-		NTType:     8,
-		Index:      24,
+		NTType:     9,
+		Index:      33,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Expr6 : ident "(" Args ")"	<< ast.NewFuncExpr(X[0], X[2]) >>`,
 		Id:         "Expr6",
-		NTType:     8,
-		Index:      25,
+		NTType:     9,
+		Index:      34,
 		NumSymbols: 4,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewFuncExpr(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Expr6 : functionName "(" Args ")"	<< ast.NewFuncExpr(X[0], X[2]) >>`,
 		Id:         "Expr6",
-		NTType:     8,
-		Index:      26,
+		NTType:     9,
+		Index:      35,
 		NumSymbols: 4,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewFuncExpr(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `PrimaryExpr : Literal	<<  >>`,
 		Id:         "PrimaryExpr",
-		NTType:     9,
-		Index:      27,
+		NTType:     10,
+		Index:      36,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `PrimaryExpr : "(" Expr ")"	<< X[1], nil >>`,
 		Id:         "PrimaryExpr",
-		NTType:     9,
-		Index:      28,
+		NTType:     10,
+		Index:      37,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
 		},
 	},
+	ProdTabEntry{
+		String: `PrimaryExpr : ident	<< ast.NewIdentExpr(X[0]) >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      38,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentExpr(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpr : ident Ref	<< ast.NewIdentExpr(X[0], X[1]) >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      39,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpr : functionName	<< ast.NewIdentExpr(X[0]) >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      40,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentExpr(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpr : functionName Ref	<< ast.NewIdentExpr(X[0], X[1]) >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      41,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpr : ArrayLit	<<  >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      42,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpr : ObjectLit	<<  >>`,
+		Id:         "PrimaryExpr",
+		NTType:     10,
+		Index:      43,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `TernaryExpr : TernaryArgument "?" TernaryArgument ":" TernaryArgument	<< ast.NewTernaryExpr(X[0], X[2], X[4]) >>`,
 		Id:         "TernaryExpr",
-		NTType:     10,
-		Index:      29,
+		NTType:     11,
+		Index:      44,
 		NumSymbols: 5,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewTernaryExpr(X[0], X[2], X[4])
 		},
 	},
 	ProdTabEntry{
 		String: `TernaryArgument : Expr	<<  >>`,
 		Id:         "TernaryArgument",
-		NTType:     11,
-		Index:      30,
+		NTType:     12,
+		Index:      45,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `TernaryArgument : TernaryExpr	<<  >>`,
 		Id:         "TernaryArgument",
-		NTType:     11,
-		Index:      31,
+		NTType:     12,
+		Index:      46,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `TernaryArgument : "(" TernaryExpr ")"	<< ast.NewTernaryArgument(X[1]) >>`,
 		Id:         "TernaryArgument",
-		NTType:     11,
-		Index:      32,
+		NTType:     12,
+		Index:      47,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewTernaryArgument(X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `BoolLit : "true"	<<  >>`,
 		Id:         "BoolLit",
-		NTType:     12,
-		Index:      33,
+		NTType:     13,
+		Index:      48,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `BoolLit : "false"	<<  >>`,
 		Id:         "BoolLit",
-		NTType:     12,
-		Index:      34,
+		NTType:     13,
+		Index:      49,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `NilLit : "nil"	<<  >>`,
 		Id:         "NilLit",
-		NTType:     13,
-		Index:      35,
+		NTType:     14,
+		Index:      50,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `NilLit : "null"	<<  >>`,
 		Id:         "NilLit",
-		NTType:     13,
-		Index:      36,
+		NTType:     14,
+		Index:      51,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : intLit	<< ast.NewLiteral("int",X[0]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      37,
+		NTType:     15,
+		Index:      52,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLiteral("int",X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : floatLit	<< ast.NewLiteral("float",X[0]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      38,
+		NTType:     15,
+		Index:      53,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLiteral("float",X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : stringLit	<< ast.NewLiteral("string",X[0]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      39,
+		NTType:     15,
+		Index:      54,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLiteral("string",X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : BoolLit	<< ast.NewLiteral("bool",X[0]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      40,
+		NTType:     15,
+		Index:      55,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLiteral("bool",X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : NilLit	<< ast.NewLiteral("nil",X[0]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      41,
+		NTType:     15,
+		Index:      56,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewLiteral("nil",X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Literal : ref Ref	<< ast.NewRefExpr(X[0], X[1]) >>`,
 		Id:         "Literal",
-		NTType:     14,
-		Index:      42,
+		NTType:     15,
+		Index:      57,
 		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewRefExpr(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Ref : selector	<< ast.Concat(X[0]) >>`,
 		Id:         "Ref",
-		NTType:     15,
-		Index:      43,
+		NTType:     16,
+		Index:      58,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Concat(X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Ref : Indexer	<< ast.Concat(X[0]) >>`,
 		Id:         "Ref",
-		NTType:     15,
-		Index:      44,
+		NTType:     16,
+		Index:      59,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.Concat(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Ref : SafeNav	<< ast.Concat(X[0]) >>`,
+		Id:         "Ref",
+		NTType:     16,
+		Index:      60,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Concat(X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `Ref : Ref selector	<< ast.Concat(X[0], X[1]) >>`,
 		Id:         "Ref",
-		NTType:     15,
-		Index:      45,
+		NTType:     16,
+		Index:      61,
 		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Concat(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Ref : Ref Indexer	<< ast.Concat(X[0], X[1]) >>`,
 		Id:         "Ref",
-		NTType:     15,
-		Index:      46,
+		NTType:     16,
+		Index:      62,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.Concat(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Ref : Ref SafeNav	<< ast.Concat(X[0], X[1]) >>`,
+		Id:         "Ref",
+		NTType:     16,
+		Index:      63,
 		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Concat(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Indexer : "[" ident "]"	<< ast.Concat(X[0], X[1], X[2]) >>`,
 		Id:         "Indexer",
-		NTType:     16,
-		Index:      47,
+		NTType:     17,
+		Index:      64,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Concat(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Indexer : "[" Fscript "]"	<< ast.Key(X[1]) >>`,
 		Id:         "Indexer",
-		NTType:     16,
-		Index:      48,
+		NTType:     17,
+		Index:      65,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Key(X[1])
 		},
 	},
+	ProdTabEntry{
+		String: `SafeNav : safeSelector	<< ast.NewSafeSelector(X[0]) >>`,
+		Id:         "SafeNav",
+		NTType:     18,
+		Index:      66,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewSafeSelector(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `SafeNav : "?[" Fscript "]"	<< ast.NewSafeIndex(X[1]) >>`,
+		Id:         "SafeNav",
+		NTType:     18,
+		Index:      67,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewSafeIndex(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `ArrayLit : "[" "]"	<< ast.NewArrayExpr(nil) >>`,
+		Id:         "ArrayLit",
+		NTType:     19,
+		Index:      68,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewArrayExpr(nil)
+		},
+	},
+	ProdTabEntry{
+		String: `ArrayLit : "[" Elements "]"	<< ast.NewArrayExpr(X[1]) >>`,
+		Id:         "ArrayLit",
+		NTType:     19,
+		Index:      69,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewArrayExpr(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Elements : Fscript	<< ast.NewExprList(X[0]) >>`,
+		Id:         "Elements",
+		NTType:     20,
+		Index:      70,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewExprList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Elements : Elements "," Fscript	<< ast.AppendToExprList(X[0], X[2]) >>`,
+		Id:         "Elements",
+		NTType:     20,
+		Index:      71,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendToExprList(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ObjectLit : "{" "}"	<< ast.NewObjectExpr(nil) >>`,
+		Id:         "ObjectLit",
+		NTType:     21,
+		Index:      72,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewObjectExpr(nil)
+		},
+	},
+	ProdTabEntry{
+		String: `ObjectLit : "{" Fields "}"	<< ast.NewObjectExpr(X[1]) >>`,
+		Id:         "ObjectLit",
+		NTType:     21,
+		Index:      73,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewObjectExpr(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Field	<< ast.NewFieldList(X[0]) >>`,
+		Id:         "Fields",
+		NTType:     22,
+		Index:      74,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFieldList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields "," Field	<< ast.AppendToFieldList(X[0], X[2]) >>`,
+		Id:         "Fields",
+		NTType:     22,
+		Index:      75,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendToFieldList(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Field : stringLit ":" Fscript	<< ast.NewField(X[0], X[2]) >>`,
+		Id:         "Field",
+		NTType:     23,
+		Index:      76,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewField(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Field : ident ":" Fscript	<< ast.NewField(X[0], X[2]) >>`,
+		Id:         "Field",
+		NTType:     23,
+		Index:      77,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewField(X[0], X[2])
+		},
+	},
 	ProdTabEntry{
 		String: `Args : empty	<<  >>`,
 		Id:         "Args",
-		NTType:     17,
-		Index:      49,
+		NTType:     24,
+		Index:      78,
 		NumSymbols: 0,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
 	ProdTabEntry{
 		String: `Args : ExprList	<<  >>`,
 		Id:         "Args",
-		NTType:     17,
-		Index:      50,
+		NTType:     24,
+		Index:      79,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ExprList : Expr	<< ast.NewExprList(X[0]) >>`,
+		String: `ExprList : Arg	<< ast.NewExprList(X[0]) >>`,
 		Id:         "ExprList",
-		NTType:     18,
-		Index:      51,
+		NTType:     25,
+		Index:      80,
 		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewExprList(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `ExprList : ExprList "," Expr	<< ast.AppendToExprList(X[0], X[2]) >>`,
+		String: `ExprList : ExprList "," Arg	<< ast.AppendToExprList(X[0], X[2]) >>`,
 		Id:         "ExprList",
-		NTType:     18,
-		Index:      52,
+		NTType:     25,
+		Index:      81,
 		NumSymbols: 3,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.AppendToExprList(X[0], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `Arg : Expr	<<  >>`,
+		Id:         "Arg",
+		NTType:     26,
+		Index:      82,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Arg : Lambda	<<  >>`,
+		Id:         "Arg",
+		NTType:     26,
+		Index:      83,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Lambda : ident "=>" Expr	<< ast.NewLambdaExpr(X[0], X[2]) >>`,
+		Id:         "Lambda",
+		NTType:     27,
+		Index:      84,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLambdaExpr(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Lambda : "(" ")" "=>" Expr	<< ast.NewLambdaExpr(nil, X[3]) >>`,
+		Id:         "Lambda",
+		NTType:     27,
+		Index:      85,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLambdaExpr(nil, X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Lambda : "(" LambdaParams ")" "=>" Expr	<< ast.NewLambdaExpr(X[1], X[4]) >>`,
+		Id:         "Lambda",
+		NTType:     27,
+		Index:      86,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLambdaExpr(X[1], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `LambdaParams : ident "," ident	<< ast.NewLambdaParams(X[0], X[2]) >>`,
+		Id:         "LambdaParams",
+		NTType:     28,
+		Index:      87,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLambdaParams(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `LambdaParams : LambdaParams "," ident	<< ast.AppendToLambdaParams(X[0], X[2]) >>`,
+		Id:         "LambdaParams",
+		NTType:     28,
+		Index:      88,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendToLambdaParams(X[0], X[2])
+		},
+	},
 }
@@ -3,7 +3,10 @@
 package token
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 )
 
 type Token struct {
@@ -20,13 +23,20 @@ const (
 )
 
 type Pos struct {
-	Offset int
-	Line   int
-	Column int
+	Offset  int
+	Line    int
+	Column  int
+	Context Context
 }
 
 func (p Pos) String() string {
-	return fmt.Sprintf("Pos(offset=%d, line=%d, column=%d)", p.Offset, p.Line, p.Column)
+	// If the context provides a filename, provide a human-readable File:Line:Column representation.
+	switch src := p.Context.(type) {
+	case Sourcer:
+		return fmt.Sprintf("%s:%d:%d", src.Source(), p.Line, p.Column)
+	default:
+		return fmt.Sprintf("Pos(offset=%d, line=%d, column=%d)", p.Offset, p.Line, p.Column)
+	}
 }
 
 type TokenMap struct {
@@ -49,7 +59,6 @@ func (m TokenMap) Type(tok string) Type {
 }
 
 func (m TokenMap) TokenString(tok *Token) string {
-	//TODO: refactor to print pos & token string properly
 	return fmt.Sprintf("%s(%d,%s)", m.Id(tok.Type), tok.Type, tok.Lit)
 }
 
@@ -57,10 +66,79 @@ func (m TokenMap) StringType(typ Type) string {
 	return fmt.Sprintf("%s(%d)", m.Id(typ), typ)
 }
 
+// Equals returns returns true if the token Type and Lit are matches.
+func (t *Token) Equals(rhs interface{}) bool {
+	switch rhsT := rhs.(type) {
+	case *Token:
+		return t == rhsT || (t.Type == rhsT.Type && bytes.Equal(t.Lit, rhsT.Lit))
+	default:
+		return false
+	}
+}
+
+// CharLiteralValue returns the string value of the char literal.
+func (t *Token) CharLiteralValue() string {
+	return string(t.Lit[1 : len(t.Lit)-1])
+}
+
+// Float32Value returns the float32 value of the token or an error if the token literal does not
+// denote a valid float32.
+func (t *Token) Float32Value() (float32, error) {
+	if v, err := strconv.ParseFloat(string(t.Lit), 32); err != nil {
+		return 0, err
+	} else {
+		return float32(v), nil
+	}
+}
+
+// Float64Value returns the float64 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Float64Value() (float64, error) {
+	return strconv.ParseFloat(string(t.Lit), 64)
+}
+
+// IDValue returns the string representation of an identifier token.
+func (t *Token) IDValue() string {
+	return string(t.Lit)
+}
+
+// Int32Value returns the int32 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Int32Value() (int32, error) {
+	if v, err := strconv.ParseInt(string(t.Lit), 10, 64); err != nil {
+		return 0, err
+	} else {
+		return int32(v), nil
+	}
+}
+
+// Int64Value returns the int64 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Int64Value() (int64, error) {
+	return strconv.ParseInt(string(t.Lit), 10, 64)
+}
+
+// UTF8Rune decodes the UTF8 rune in the token literal. It returns utf8.RuneError if
+// the token literal contains an invalid rune.
+func (t *Token) UTF8Rune() (rune, error) {
+	r, _ := utf8.DecodeRune(t.Lit)
+	if r == utf8.RuneError {
+		err := fmt.Errorf("Invalid rune")
+		return r, err
+	}
+	return r, nil
+}
+
+// StringValue returns the string value of the token literal.
+func (t *Token) StringValue() string {
+	return string(t.Lit[1 : len(t.Lit)-1])
+}
+
 var TokMap = TokenMap{
 	typeMap: []string{
 		"INVALID",
-		"$",
+		"␚",
+		"??",
 		"||",
 		"&&",
 		"==",
@@ -69,6 +147,13 @@ var TokMap = TokenMap{
 		"<=",
 		">",
 		">=",
+		"=~",
+		"!~",
+		"in",
+		"not",
+		"contains",
+		"startsWith",
+		"endsWith",
 		"+",
 		"-",
 		"*",
@@ -92,45 +177,63 @@ var TokMap = TokenMap{
 		"selector",
 		"[",
 		"]",
-		"empty",
+		"safeSelector",
+		"?[",
 		",",
+		"{",
+		"}",
+		"empty",
+		"=>",
 	},
 
 	idMap: map[string]Type{
 		"INVALID":      0,
-		"$":            1,
-		"||":           2,
-		"&&":           3,
-		"==":           4,
-		"!=":           5,
-		"<":            6,
-		"<=":           7,
-		">":            8,
-		">=":           9,
-		"+":            10,
-		"-":            11,
-		"*":            12,
-		"/":            13,
-		"%":            14,
-		"!":            15,
-		"ident":        16,
-		"(":            17,
-		")":            18,
-		"functionName": 19,
-		"?":            20,
-		":":            21,
-		"true":         22,
-		"false":        23,
-		"nil":          24,
-		"null":         25,
-		"intLit":       26,
-		"floatLit":     27,
-		"stringLit":    28,
-		"ref":          29,
-		"selector":     30,
-		"[":            31,
-		"]":            32,
-		"empty":        33,
-		",":            34,
+		"␚":            1,
+		"??":           2,
+		"||":           3,
+		"&&":           4,
+		"==":           5,
+		"!=":           6,
+		"<":            7,
+		"<=":           8,
+		">":            9,
+		">=":           10,
+		"=~":           11,
+		"!~":           12,
+		"in":           13,
+		"not":          14,
+		"contains":     15,
+		"startsWith":   16,
+		"endsWith":     17,
+		"+":            18,
+		"-":            19,
+		"*":            20,
+		"/":            21,
+		"%":            22,
+		"!":            23,
+		"ident":        24,
+		"(":            25,
+		")":            26,
+		"functionName": 27,
+		"?":            28,
+		":":            29,
+		"true":         30,
+		"false":        31,
+		"nil":          32,
+		"null":         33,
+		"intLit":       34,
+		"floatLit":     35,
+		"stringLit":    36,
+		"ref":          37,
+		"selector":     38,
+		"[":            39,
+		"]":            40,
+		"safeSelector": 41,
+		"?[":           42,
+		",":            43,
+		"{":            44,
+		"}":            45,
+		"empty":        46,
+		"=>":           47,
 	},
 }
@@ -0,0 +1,73 @@
+package script
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLambdaExpr(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"name": "a", "price": 5, "tags": []interface{}{"x"}},
+		map[string]interface{}{"name": "b", "price": 20.5, "tags": []interface{}{"y", "z"}},
+		map[string]interface{}{"name": "c", "price": 12, "tags": []interface{}{}},
+	}
+	scope := data.NewSimpleScope(map[string]interface{}{"items": items, "min": 10, "x": "outer"}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := map[string]interface{}{
+		`array.filter($.items, x => x.price > 10)`:                                              []interface{}{items[1], items[2]},
+		`array.filter($.items, item => item.price > $.min && item.name != "c")`:                 []interface{}{items[1]},
+		`array.map($.items, x => x.name)`:                                                       []interface{}{"a", "b", "c"},
+		`array.map($.items, (x, i) => i)`:                                                       []interface{}{0, 1, 2},
+		`array.map(array.filter($.items, x => builtin.len(x.tags) > 0), x => x.tags[0])`:        []interface{}{"x", "y"},
+		`array.map($.items, x => x["name"])`:                                                    []interface{}{"a", "b", "c"},
+		`array.map($.items, x => string.toUpper(x.name))`:                                       []interface{}{"A", "B", "C"},
+		`array.map($.items, () => $.x)`:                                                         []interface{}{"outer", "outer", "outer"},
+		`array.some($.items, x => array.contains(x.tags, "z"))`:                                 true,
+		`array.every($.items, x => x.price > 1)`:                                                true,
+		`array.reduce($.items, (total, x) => total + x.price, 0)`:                               37.5,
+		`array.sortBy($.items, x => 0 - x.price)`:                                               []interface{}{items[1], items[2], items[0]},
+		`array.map(array.filter($.items, x => array.some(x.tags, t => t == "y")), x => x.name)`: []interface{}{"b"},
+		`array.map($.items, x => array.map(x.tags, x => x))`:                                    []interface{}{[]interface{}{"x"}, []interface{}{"y", "z"}, []interface{}{}},
+	}
+
+	for k, v := range testcases {
+		expr, err := factory.NewExpr(k)
+		if !assert.Nil(t, err, k) {
+			continue
+		}
+		result, err := expr.Eval(scope)
+		assert.Nil(t, err, k)
+		assert.Equal(t, v, result, k)
+	}
+
+	expr, err := factory.NewExpr(`array.find($.items, x => x.price == 12)`)
+	assert.Nil(t, err)
+	result, err := expr.Eval(scope)
+	assert.Nil(t, err)
+	assert.Equal(t, items[2], result)
+}
+
+func TestLambdaExprErrors(t *testing.T) {
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	_, err := factory.NewExpr(`array.map($.items, x => y.name)`)
+	assert.NotNil(t, err)
+
+	_, err = factory.NewExpr(`x.name == "a"`)
+	assert.NotNil(t, err)
+
+	_, err = factory.NewExpr(`array.map($.items, (x, x) => x)`)
+	assert.NotNil(t, err)
+
+	_, err = factory.NewExpr(`x => x.name`)
+	assert.NotNil(t, err)
+
+	expr, err := factory.NewExpr(`array.map($.items, "name")`)
+	assert.Nil(t, err)
+	_, err = expr.Eval(data.NewSimpleScope(map[string]interface{}{"items": []interface{}{1}}, nil))
+	assert.NotNil(t, err)
+}
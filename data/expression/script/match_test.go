@@ -0,0 +1,86 @@
+package script
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOperators(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{
+		"path":    "/orders/123",
+		"pattern": "^/customers/",
+		"tags":    []interface{}{"a", "b", 3},
+		"codes":   []int{200, 201},
+		"headers": map[string]interface{}{"Accept": "json"},
+		"params":  map[string]string{"id": "1"},
+		"code":    201,
+		"missing": nil,
+		"in":      "x",
+	}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := map[string]interface{}{
+		`$.path =~ "^/orders/[0-9]+$"`:   true,
+		`$.path =~ "customers"`:          false,
+		`$.path !~ "^/orders/"`:          false,
+		`$.path =~ $.pattern`:            false,
+		`$.path !~ $.pattern`:            true,
+		`$.missing =~ ".*"`:              false,
+		`$.missing !~ ".*"`:              true,
+		`"b" in $.tags`:                  true,
+		`3 in $.tags`:                    true,
+		`3.0 in $.tags`:                  true,
+		`"c" in $.tags`:                  false,
+		`"c" not in $.tags`:              true,
+		`$.code in $.codes`:              true,
+		`"Accept" in $.headers`:          true,
+		`"id" in $.params`:               true,
+		`"name" not in $.params`:         true,
+		`"a" in $.missing`:               false,
+		`"ord" in $.path`:                true,
+		`$.path contains "/123"`:         true,
+		`$.tags contains "a"`:            true,
+		`$.headers contains "Other"`:     false,
+		`$.path startsWith "/orders"`:    true,
+		`$.path endsWith "124"`:          false,
+		`$.missing startsWith "a"`:       false,
+		`$.in == "x" && "a" in $.tags`:   true,
+		`$.code in $.codes ? "ok" : "x"`: "ok",
+		`"b" in $.tags == true`:          true,
+	}
+
+	for exprStr, expected := range testcases {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		if err != nil {
+			continue
+		}
+		v, err := expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, expected, v, exprStr)
+	}
+
+	_, err := factory.NewExpr(`$.path =~ "[a-"`)
+	assert.NotNil(t, err)
+
+	expr, err := factory.NewExpr(`$.path =~ $.pattern + "["`)
+	assert.Nil(t, err)
+	_, err = expr.Eval(scope)
+	assert.NotNil(t, err)
+
+	expr, err = factory.NewExpr(`"a" in $.code`)
+	assert.Nil(t, err)
+	_, err = expr.Eval(scope)
+	assert.NotNil(t, err)
+
+	// the operator keywords are reserved, they can't be used as names
+	for _, name := range []string{"in", "not", "contains", "startsWith", "endsWith"} {
+		_, err = factory.NewExpr(`array.map($.tags, ` + name + ` => ` + name + `)`)
+		assert.NotNil(t, err, name)
+		_, err = factory.NewExpr(`$.` + name)
+		assert.Nil(t, err, name)
+	}
+}
@@ -0,0 +1,132 @@
+package script
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesceAndSafeNavigation(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{
+		"order": map[string]interface{}{
+			"id":       "a-1",
+			"customer": nil,
+			"items":    []interface{}{map[string]interface{}{"name": "x"}},
+			"params":   map[string]string{"k": "v"},
+			"count":    0,
+		},
+		"index": 5,
+	}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	testcases := map[string]interface{}{
+		`$.order.id ?? "none"`:                                    "a-1",
+		`$.order.customer ?? "none"`:                              "none",
+		`$.order.count ?? 10`:                                     0,
+		`$.missing ?? "none"`:                                     "none",
+		`$.missing ?? $.other ?? "last"`:                          "last",
+		`$.order?.customer?.name`:                                 nil,
+		`$.order.customer?.address.city`:                          nil,
+		`$.order.customer?.name ?? "anonymous"`:                   "anonymous",
+		`$.missing?.name`:                                         nil,
+		`$.order?.id`:                                             "a-1",
+		`$.order?.nope`:                                           nil,
+		`$.order.items?[0].name`:                                  "x",
+		`$.order.items?[$.index]?.name ?? "no item"`:              "no item",
+		`$.order.items?[0]?["name"]`:                              "x",
+		`$.order.params?.k`:                                       "v",
+		`$.order.params?["nope"] ?? "default"`:                    "default",
+		`$.order.customer == nil ? "guest" : "member"`:            "guest",
+		`array.map($.order.items, x => x?.name?.first ?? x.name)`: []interface{}{"x"},
+		`$.order.nope.x.y ?? "d"`:                                 "d",
+		`$.order.customer.name ?? "none"`:                         "none",
+		`$.order.items[5] ?? "d"`:                                 "d",
+		`$.order.items[5].name ?? "d"`:                            "d",
+		`$.order.items[0].nope.x ?? "d"`:                          "d",
+		`true ? [1] : [2]`:                                        []interface{}{1},
+		`$.order.customer == nil ? ["guest"] : []`:                []interface{}{"guest"},
+	}
+
+	for k, v := range testcases {
+		expr, err := factory.NewExpr(k)
+		if !assert.Nil(t, err, k) {
+			continue
+		}
+		result, err := expr.Eval(scope)
+		assert.Nil(t, err, k)
+		assert.Equal(t, v, result, k)
+	}
+
+	// navigation without the safe operator or a default still fails on missing values
+	for _, exprStr := range []string{`$.order.customer.name`, `$.order.nope.x.y`, `$.order.items[5]`, `$.order.items?[0].name.first`} {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		_, err = expr.Eval(scope)
+		assert.NotNil(t, err, exprStr)
+	}
+
+	// errors other than missing values are not defaulted
+	expr, err := factory.NewExpr(`$.order.id.x ?? "d"`)
+	assert.Nil(t, err)
+	_, err = expr.Eval(scope)
+	assert.NotNil(t, err)
+
+	// ?[ is always read as a safe index, the ? of a ternary needs a space before an array literal
+	for _, exprStr := range []string{`true ?[1] : [2]`, `$.order.customer == nil ?["guest"] : []`} {
+		_, err = factory.NewExpr(exprStr)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestIsDefinedNotFound(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"a": 1}, nil)
+	factory := NewExprFactory(resolve.GetBasicResolver())
+
+	for _, exprStr := range []string{`isdefined($.b)`, `isdefined($.b.c)`, `isdefined($.a.c)`} {
+		expr, err := factory.NewExpr(exprStr)
+		assert.Nil(t, err, exprStr)
+		v, err := expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, false, v, exprStr)
+	}
+}
+
+type untypedActivityResolver struct {
+}
+
+func (*untypedActivityResolver) GetResolverInfo() *resolve.ResolverInfo {
+	return resolve.NewResolverInfo(false, true)
+}
+
+func (*untypedActivityResolver) Resolve(scope data.Scope, item string, field string) (interface{}, error) {
+	if item == "log" && field == "level" {
+		return "info", nil
+	}
+	return nil, fmt.Errorf("failed to resolve activity attr: '%s', not found in activity '%s'", field, item)
+}
+
+func TestIsDefinedUntypedNotFound(t *testing.T) {
+	resolver := resolve.NewCompositeResolver(map[string]resolve.Resolver{".": &resolve.ScopeResolver{}, "activity": &untypedActivityResolver{}})
+	factory := NewExprFactory(resolver)
+	scope := data.NewSimpleScope(nil, nil)
+
+	testcases := map[string]interface{}{
+		`isdefined($activity[log].message)`:           false,
+		`isdefined($activity[log].level)`:             true,
+		`getValue($activity[log].message, "default")`: "default",
+		`getValue($activity[log].level, "default")`:   "info",
+	}
+
+	for exprStr, expected := range testcases {
+		expr, err := factory.NewExpr(exprStr)
+		if !assert.Nil(t, err, exprStr) {
+			continue
+		}
+		v, err := expr.Eval(scope)
+		assert.Nil(t, err, exprStr)
+		assert.Equal(t, expected, v, exprStr)
+	}
+}
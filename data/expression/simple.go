@@ -17,7 +17,19 @@ func (e *literalExpr) Eval(scope data.Scope) (interface{}, error) {
 	return e.val, nil
 }
 
+func (e *literalExpr) Check(env *CheckEnv) (data.Type, []error) {
+	if e.val == nil {
+		return data.TypeAny, nil
+	}
+	t, err := data.GetType(e.val)
+	if err != nil {
+		return data.TypeAny, nil
+	}
+	return t, nil
+}
+
 type resolutionExpr struct {
+	ref        string
 	resolution resolve.Resolution
 }
 
@@ -25,3 +37,11 @@ func (e *resolutionExpr) Eval(scope data.Scope) (interface{}, error) {
 
 	return e.resolution.GetValue(scope)
 }
+
+func (e *resolutionExpr) Check(env *CheckEnv) (data.Type, []error) {
+	t, err := CheckRef(e.ref, env)
+	if err != nil {
+		return t, []error{err}
+	}
+	return t, nil
+}
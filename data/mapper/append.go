@@ -0,0 +1,83 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression"
+)
+
+const (
+	Append = "@append"
+)
+
+// appendMapper evaluates to a copy of its source array with the mapped elements appended, ex.
+//
+//	"items": {
+//	    "@append($.order.items)": [{"sku": "=$.sku", "qty": 1}]
+//	}
+type appendMapper struct {
+	source   expression.Expr
+	elements []expression.Expr
+}
+
+func (am *appendMapper) Eval(scope data.Scope) (interface{}, error) {
+	val, err := am.source.Eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var arr []interface{}
+	if val != nil {
+		kind := reflect.TypeOf(val).Kind()
+		if kind == reflect.Slice || kind == reflect.Array || kind == reflect.String {
+			arr, err = coerce.ToArray(val)
+		}
+		if arr == nil || err != nil {
+			return nil, fmt.Errorf("unable to append to %T, it is not an array", val)
+		}
+	}
+
+	result := make([]interface{}, 0, len(arr)+len(am.elements))
+	for _, v := range arr {
+		result = append(result, deepCopy(v))
+	}
+	for _, elem := range am.elements {
+		v, err := elem.Eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func createAppendMapper(key string, elements interface{}, ef expression.Factory) (*appendMapper, error) {
+	source, ok := getConditionArgument(key)
+	if !ok || strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("%s requires a target array, ex. %s($.items)", Append, Append)
+	}
+	sourceExpr, err := ef.NewExpr(strings.TrimSpace(source))
+	if err != nil {
+		return nil, fmt.Errorf("create %s target expression error: %s", Append, err.Error())
+	}
+
+	elems, ok := elements.([]interface{})
+	if !ok {
+		// a single element
+		elems = []interface{}{elements}
+	}
+
+	am := &appendMapper{source: sourceExpr}
+	for _, e := range elems {
+		mapper, err := NewObjectMapper(e, ef)
+		if err != nil {
+			return nil, err
+		}
+		am.elements = append(am.elements, mapper)
+	}
+	return am, nil
+}
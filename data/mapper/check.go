@@ -0,0 +1,72 @@
+package mapper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+)
+
+// CheckMappings statically checks mappings without evaluating them. The expressions are checked against the
+// values described by env and, if target is specified, the mapped values against the target metadata. The
+// location of a diagnostic is the name of the mapping it was found in.
+func CheckMappings(ef expression.Factory, mappings map[string]interface{}, env *expression.CheckEnv, target map[string]data.TypedValue) []*expression.Diagnostic {
+
+	keys := make([]string, 0, len(mappings))
+	for key := range mappings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var diagnostics []*expression.Diagnostic
+	for _, key := range keys {
+		value := mappings[key]
+		if value == nil {
+			continue
+		}
+
+		var targetType data.Type
+		if len(target) > 0 {
+			name := key
+			if idx := strings.IndexAny(key, ".["); idx > 0 {
+				name = key[:idx]
+			}
+			tv, exists := target[name]
+			if !exists {
+				diagnostics = append(diagnostics, &expression.Diagnostic{Location: key, Err: fmt.Errorf("unknown field '%s'", name)})
+				continue
+			}
+			if name == key {
+				targetType = tv.Type()
+			}
+		}
+
+		var valueType data.Type
+		if exprStr, ok := value.(string); ok && isExpr(exprStr) {
+			expr, err := ef.NewExpr(exprStr[1:])
+			if err != nil {
+				diagnostics = append(diagnostics, &expression.Diagnostic{Location: key, Err: err})
+				continue
+			}
+			var errs []error
+			valueType, errs = expression.Check(expr, env)
+			for _, err := range errs {
+				diagnostics = append(diagnostics, &expression.Diagnostic{Location: key, Err: err})
+			}
+		} else if IsConditionalMapping(value) {
+			valueType = data.TypeAny
+		} else if _, ok := GetObjectMapping(value); ok {
+			valueType = data.TypeAny
+		} else {
+			valueType, _ = expression.Check(expression.NewLiteralExpr(value), env)
+		}
+
+		if !expression.CanCoerce(valueType, targetType) {
+			diagnostics = append(diagnostics, &expression.Diagnostic{Location: key, Err: fmt.Errorf("cannot coerce %s to %s", valueType, targetType)})
+		}
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,50 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/resolve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckMappings(t *testing.T) {
+	env := &expression.CheckEnv{Scope: map[string]data.TypedValue{
+		"id":      data.NewAttribute("id", data.TypeString, nil),
+		"content": data.NewAttribute("content", data.TypeObject, nil),
+	}}
+	target := map[string]data.TypedValue{
+		"orderId": data.NewAttribute("orderId", data.TypeInt, nil),
+		"order":   data.NewAttribute("order", data.TypeObject, nil),
+		"count":   data.NewAttribute("count", data.TypeInt, nil),
+		"note":    data.NewAttribute("note", data.TypeString, nil),
+	}
+	ef := expression.NewFactory(resolve.GetBasicResolver())
+
+	mappings := map[string]interface{}{
+		"orderId":     "=$.id",
+		"order":       "=$.content",
+		"note":        "=$.content.note",
+		"count":       "=$.content",
+		"order.total": "=$.cotnent.total",
+		"nope":        "=$.id",
+	}
+
+	diagnostics := CheckMappings(ef, mappings, env, target)
+	if assert.Len(t, diagnostics, 3) {
+		assert.Equal(t, "count: cannot coerce object to int", diagnostics[0].Error())
+		assert.Equal(t, "nope: unknown field 'nope'", diagnostics[1].Error())
+		assert.Equal(t, "order.total: unknown reference '$.cotnent.total'", diagnostics[2].Error())
+	}
+
+	// without target metadata only the expressions are checked
+	diagnostics = CheckMappings(ef, mappings, env, nil)
+	assert.Len(t, diagnostics, 1)
+
+	literals := map[string]interface{}{"count": "10", "order": map[string]interface{}{"a": 1}, "note": true}
+	assert.Empty(t, CheckMappings(ef, literals, env, target))
+
+	literals = map[string]interface{}{"count": map[string]interface{}{"a": 1}}
+	assert.Len(t, CheckMappings(ef, literals, env, target), 1)
+}
@@ -37,6 +37,35 @@ func (fs *ConditionalMapper) Eval(scope data.Scope) (interface{}, error) {
 	return nil, nil
 }
 
+// Explain evaluates the conditions in order until one is true, explaining each condition evaluated and the
+// mapping that was applied
+func (fs *ConditionalMapper) Explain(scope data.Scope) (interface{}, *expression.Explanation, error) {
+	ex := &expression.Explanation{Type: "conditional"}
+	for _, condition := range fs.conditions {
+		keyResult, ce, err := expression.Explain(condition.key, scope)
+		ex.Children = append(ex.Children, &expression.Explanation{Type: "condition", Value: keyResult, Error: ce.Error, Children: []*expression.Explanation{ce}})
+		if err != nil {
+			ex.SetResult(nil, err)
+			return nil, ex, err
+		}
+		ok, _ := coerce.ToBool(keyResult)
+		if ok {
+			return explainMapping(ex, condition.mapper, scope)
+		}
+	}
+	if fs.otherwise != nil {
+		return explainMapping(ex, fs.otherwise, scope)
+	}
+	return nil, ex, nil
+}
+
+func explainMapping(ex *expression.Explanation, mapping expression.Expr, scope data.Scope) (interface{}, *expression.Explanation, error) {
+	val, me, err := expression.Explain(mapping, scope)
+	ex.Children = append(ex.Children, me)
+	ex.SetResult(val, err)
+	return val, ex, err
+}
+
 type conditionalExpr struct {
 	key    expression.Expr
 	mapper expression.Expr
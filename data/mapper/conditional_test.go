@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -638,3 +639,36 @@ func TestNestedConditionalArrayMapper(t *testing.T) {
 		}
 	}
 }
+
+func TestConditionalMapperExplain(t *testing.T) {
+	mapping := map[string]interface{}{
+		"@conditional": []interface{}{
+			map[string]interface{}{"$.person.age > 60": "senior"},
+			map[string]interface{}{"$.person.age > 18": "adult"},
+			map[string]interface{}{"@otherwise": "minor"},
+		},
+	}
+	ef := expression.NewFactory(resolver)
+	expr, err := createConditionalMapper(mapping, ef)
+	assert.Nil(t, err)
+	conditional, ok := expr.(*ConditionalMapper)
+	assert.True(t, ok)
+
+	scope := data.NewSimpleScope(map[string]interface{}{"person": map[string]interface{}{"age": 30}}, nil)
+	val, ex, err := conditional.Explain(scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "adult", val)
+	assert.Equal(t, "conditional", ex.Type)
+	// both conditions and the applied mapping
+	assert.Len(t, ex.Children, 3)
+	assert.Equal(t, false, ex.Children[0].Value)
+	assert.Equal(t, "30 > 60", ex.Children[0].Children[0].Evaluation)
+	assert.Equal(t, true, ex.Children[1].Value)
+	assert.Equal(t, "adult", ex.Children[2].Value)
+
+	scope = data.NewSimpleScope(map[string]interface{}{"person": map[string]interface{}{"age": 10}}, nil)
+	val, ex, err = conditional.Explain(scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "minor", val)
+	assert.Len(t, ex.Children, 3)
+}
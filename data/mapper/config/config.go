@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -12,6 +13,13 @@ const (
 	EnvMappingSkipMissing        = "FLOGO_MAPPING_SKIP_MISSING"
 	EnvMappingSkipMissingDefault = false
 	EnvMapperOmitNulls           = "FLOGO_MAPPING_OMIT_NULLS"
+
+	EnvMappingCheck        = "FLOGO_MAPPING_CHECK"
+	EnvMappingCheckDefault = MappingCheckWarn
+
+	MappingCheckOff   = "off"
+	MappingCheckWarn  = "warn"
+	MappingCheckError = "error"
 )
 
 func IsMappingIgnoreErrorsOn() bool {
@@ -31,3 +39,14 @@ func IsMappingSkipMissingOn() bool {
 	b, _ := strconv.ParseBool(skip)
 	return b
 }
+
+// GetMappingCheckMode returns how problems found by statically checking mappings at app load are handled,
+// they are either ignored (off), logged (warn) or fail the app (error)
+func GetMappingCheckMode() string {
+	mode := strings.ToLower(os.Getenv(EnvMappingCheck))
+	switch mode {
+	case MappingCheckOff, MappingCheckWarn, MappingCheckError:
+		return mode
+	}
+	return EnvMappingCheckDefault
+}
@@ -19,3 +19,18 @@ func TestIsMappingRelaxed(t *testing.T) {
 	}()
 
 }
+
+func TestGetMappingCheckMode(t *testing.T) {
+	defer os.Unsetenv(EnvMappingCheck)
+
+	assert.Equal(t, MappingCheckWarn, GetMappingCheckMode())
+
+	os.Setenv(EnvMappingCheck, "ERROR")
+	assert.Equal(t, MappingCheckError, GetMappingCheckMode())
+
+	os.Setenv(EnvMappingCheck, "off")
+	assert.Equal(t, MappingCheckOff, GetMappingCheckMode())
+
+	os.Setenv(EnvMappingCheck, "invalid")
+	assert.Equal(t, MappingCheckWarn, GetMappingCheckMode())
+}
@@ -0,0 +1,138 @@
+package mapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/expression"
+)
+
+const (
+	Delete = "@delete"
+)
+
+// deleteMapper evaluates to a copy of its source with the specified fields removed, ex.
+//
+//	"customer": {
+//	    "@delete($.customer)": ["password", "address.zip", "cards[*].number"]
+//	}
+type deleteMapper struct {
+	source expression.Expr
+	paths  [][]string
+}
+
+func (dm *deleteMapper) Eval(scope data.Scope) (interface{}, error) {
+	val, err := dm.source.Eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	val = deepCopy(val)
+	for _, p := range dm.paths {
+		val = deletePath(val, p)
+	}
+	return val, nil
+}
+
+// deletePath removes the value at the path, a missing path is ignored
+func deletePath(val interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return val
+	}
+	key, rest := path[0], path[1:]
+
+	switch t := val.(type) {
+	case map[string]interface{}:
+		if len(rest) == 0 {
+			delete(t, key)
+		} else if child, ok := t[key]; ok {
+			t[key] = deletePath(child, rest)
+		}
+	case []interface{}:
+		if key == "*" {
+			if len(rest) == 0 {
+				return t[:0]
+			}
+			for i, child := range t {
+				t[i] = deletePath(child, rest)
+			}
+			return t
+		}
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx >= len(t) {
+			return t
+		}
+		if len(rest) == 0 {
+			return append(t[:idx], t[idx+1:]...)
+		}
+		t[idx] = deletePath(t[idx], rest)
+	}
+	return val
+}
+
+// parseDeletePath splits a path such as a.b[0]["c.d"] into its keys
+func parseDeletePath(p string) ([]string, error) {
+	var keys []string
+	for i := 0; i < len(p); {
+		switch p[i] {
+		case '.':
+			i++
+		case '[':
+			end := strings.IndexByte(p[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path '%s'", p)
+			}
+			key := p[i+1 : i+end]
+			if len(key) > 1 && (key[0] == '"' || key[0] == '\'') && key[len(key)-1] == key[0] {
+				// a quoted key can contain '.' but not ']'
+				key = key[1 : len(key)-1]
+			}
+			keys = append(keys, key)
+			i += end + 1
+		default:
+			end := strings.IndexAny(p[i:], ".[")
+			if end < 0 {
+				end = len(p) - i
+			}
+			keys = append(keys, p[i:i+end])
+			i += end
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("invalid path '%s'", p)
+	}
+	return keys, nil
+}
+
+func createDeleteMapper(key string, fields interface{}, ef expression.Factory) (*deleteMapper, error) {
+	source, ok := getConditionArgument(key)
+	if !ok || strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("%s requires a source, ex. %s($.value)", Delete, Delete)
+	}
+	sourceExpr, err := ef.NewExpr(strings.TrimSpace(source))
+	if err != nil {
+		return nil, fmt.Errorf("create %s source expression error: %s", Delete, err.Error())
+	}
+
+	paths, err := coerce.ToArray(fields)
+	if err != nil {
+		return nil, fmt.Errorf("%s fields must be an array of paths: %s", Delete, err.Error())
+	}
+
+	dm := &deleteMapper{source: sourceExpr}
+	for _, p := range paths {
+		ps, ok := p.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s field must be a path, got %v", Delete, p)
+		}
+		keys, err := parseDeletePath(ps)
+		if err != nil {
+			return nil, err
+		}
+		dm.paths = append(dm.paths, keys)
+	}
+	return dm, nil
+}
@@ -34,6 +34,49 @@ func TestLiteralMapper(t *testing.T) {
 	//todo add util to do set to handle Obj.key, Params.paramKey etc
 }
 
+func TestLiteralExprMapper(t *testing.T) {
+
+	mappings := map[string]interface{}{"Arr": "=[$.id, 2]", "Obj": `={"id": $.id, "tags": [$.tag]}`}
+
+	factory := NewFactory(resolve.GetBasicResolver())
+	mapper, err := factory.NewMapper(mappings)
+	assert.Nil(t, err)
+
+	scope := data.NewSimpleScope(map[string]interface{}{"id": 1, "tag": "a"}, nil)
+	results, err := mapper.Apply(scope)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []interface{}{1, 2}, results["Arr"])
+	assert.Equal(t, map[string]interface{}{"id": 1, "tags": []interface{}{"a"}}, results["Obj"])
+}
+
+func TestJSONPathMapper(t *testing.T) {
+
+	mappings := map[string]interface{}{
+		"Ids":      `=json.path($.body, "$.items[*].id")`,
+		"First":    `=json.path($.body, "$.items[0].name")`,
+		"HasLarge": `=json.exists($.body, "$.items[?(@.qty > 10)]")`,
+	}
+
+	factory := NewFactory(resolve.GetBasicResolver())
+	mapper, err := factory.NewMapper(mappings)
+	assert.Nil(t, err)
+
+	// the body can be parsed or the raw JSON
+	for _, body := range []interface{}{
+		map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": 1.0, "name": "a", "qty": 2.0}, map[string]interface{}{"id": 2.0, "name": "b", "qty": 20.0}}},
+		`{"items": [{"id": 1, "name": "a", "qty": 2}, {"id": 2, "name": "b", "qty": 20}]}`,
+	} {
+		scope := data.NewSimpleScope(map[string]interface{}{"body": body}, nil)
+		results, err := mapper.Apply(scope)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []interface{}{1.0, 2.0}, results["Ids"])
+		assert.Equal(t, "a", results["First"])
+		assert.Equal(t, true, results["HasLarge"])
+	}
+}
+
 func TestAssignMapper(t *testing.T) {
 
 	mappings := map[string]interface{}{"One": "=$.SimpleI", "Two": "=$.ObjI.key", "Three": "=$.ArrayI[2]", "Four": "=$.ParamsI.paramKey", "Five": "$.SimpleI"}
@@ -1,19 +1,39 @@
 package mapper
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
 	"github.com/project-flogo/core/data/expression"
 )
 
 type mergeMapper struct {
 	mappers []expression.Expr
+	// strategy is the conflict strategy of a deep merge, if not set the results are concatenated
+	strategy string
 }
 
 const (
 	Merge = "@merge"
+
+	// MergeOverride deep merges objects, the last value of a field wins
+	MergeOverride = "override"
+	// MergeKeep deep merges objects, the first value of a field wins
+	MergeKeep = "keep"
+	// MergeAppend deep merges objects, arrays are concatenated and otherwise the last value of a field wins
+	MergeAppend = "append"
+	// MergeError deep merges objects, a field with different values is an error
+	MergeError = "error"
 )
 
 func (mm *mergeMapper) Eval(scope data.Scope) (interface{}, error) {
+	if mm.strategy != "" {
+		return mm.deepMerge(scope)
+	}
+
 	var combinedResult []interface{}
 	for _, mapper := range mm.mappers {
 		evalResult, err := mapper.Eval(scope)
@@ -30,8 +50,117 @@ func (mm *mergeMapper) Eval(scope data.Scope) (interface{}, error) {
 	return combinedResult, nil
 }
 
-func createMergeMapper(merges []interface{}, ef expression.Factory) (*mergeMapper, error) {
+func (mm *mergeMapper) deepMerge(scope data.Scope) (interface{}, error) {
+	var merged interface{}
+	for _, mapper := range mm.mappers {
+		evalResult, err := mapper.Eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		if evalResult == nil {
+			continue
+		}
+		if merged == nil {
+			merged = deepCopy(evalResult)
+			continue
+		}
+		merged, err = mergeValues(merged, evalResult, mm.strategy, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// mergeValues merges src into dst, dst is modified and src is copied so the values being merged are not changed
+func mergeValues(dst, src interface{}, strategy, path string) (interface{}, error) {
+	dstObj, dstIsObj := toMergeObject(dst)
+	srcObj, srcIsObj := toMergeObject(src)
+	if dstIsObj && srcIsObj {
+		keys := make([]string, 0, len(srcObj))
+		for k := range srcObj {
+			keys = append(keys, k)
+		}
+		// fields are merged in order so conflicts are reported consistently
+		sort.Strings(keys)
+		for _, k := range keys {
+			sv := srcObj[k]
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			dv, exists := dstObj[k]
+			if !exists || dv == nil {
+				dstObj[k] = deepCopy(sv)
+				continue
+			}
+			if sv == nil {
+				continue
+			}
+			merged, err := mergeValues(dv, sv, strategy, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			dstObj[k] = merged
+		}
+		return dstObj, nil
+	}
+
+	switch strategy {
+	case MergeKeep:
+		return dst, nil
+	case MergeAppend:
+		dstArr, dstIsArr := dst.([]interface{})
+		srcArr, srcIsArr := src.([]interface{})
+		if dstIsArr && srcIsArr {
+			return append(dstArr, deepCopy(srcArr).([]interface{})...), nil
+		}
+	case MergeError:
+		if !isSameValue(dst, src) {
+			if path == "" {
+				return nil, fmt.Errorf("merge conflict, values %v and %v are different", dst, src)
+			}
+			return nil, fmt.Errorf("merge conflict at '%s', values %v and %v are different", path, dst, src)
+		}
+		return dst, nil
+	}
+	return deepCopy(src), nil
+}
+
+func toMergeObject(val interface{}) (map[string]interface{}, bool) {
+	switch t := val.(type) {
+	case map[string]interface{}:
+		return t, true
+	case map[string]string:
+		obj := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			obj[k] = v
+		}
+		return obj, true
+	}
+	return nil, false
+}
+
+func isSameValue(a, b interface{}) bool {
+	as, err := coerce.ToString(a)
+	if err != nil {
+		return false
+	}
+	bs, err := coerce.ToString(b)
+	return err == nil && as == bs
+}
+
+func createMergeMapper(key string, merges []interface{}, ef expression.Factory) (*mergeMapper, error) {
 	mergeMapper := &mergeMapper{mappers: make([]expression.Expr, 0)}
+	if strategy, ok := getConditionArgument(key); ok {
+		mergeMapper.strategy = strings.TrimSpace(strategy)
+		switch mergeMapper.strategy {
+		case MergeOverride, MergeKeep, MergeAppend, MergeError:
+		default:
+			return nil, fmt.Errorf("unsupported merge strategy '%s'", mergeMapper.strategy)
+		}
+	}
+
 	for _, v := range merges {
 		mapper, err := NewObjectMapper(v, ef)
 		if err != nil {
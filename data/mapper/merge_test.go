@@ -0,0 +1,142 @@
+package mapper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func applyObjectMapping(t *testing.T, mappingValue string, values map[string]interface{}) (interface{}, error) {
+	objectMapping := make(map[string]interface{})
+	err := json.Unmarshal([]byte(mappingValue), &objectMapping)
+	assert.Nil(t, err)
+
+	factory := NewFactory(resolver)
+	mapper, err := factory.NewMapper(map[string]interface{}{"result": objectMapping})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := mapper.Apply(data.NewSimpleScope(values, nil))
+	if err != nil {
+		return nil, err
+	}
+	return results["result"], nil
+}
+
+func TestMergeConcat(t *testing.T) {
+	mappingValue := `{"mapping": {
+        "@merge": ["=$.a", "=$.b", "c"]
+    }
+}`
+	result, err := applyObjectMapping(t, mappingValue, map[string]interface{}{"a": []interface{}{1, 2}, "b": []interface{}{3}})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, 2, 3, "c"}, result)
+}
+
+func TestMergeStrategies(t *testing.T) {
+	values := map[string]interface{}{
+		"defaults": map[string]interface{}{"name": "unknown", "tags": []interface{}{"a"}, "address": map[string]interface{}{"city": "x", "zip": "1"}},
+		"customer": map[string]interface{}{"name": "bob", "tags": []interface{}{"b"}, "address": map[string]interface{}{"city": "y"}, "age": nil},
+	}
+
+	mapping := func(strategy string) string {
+		return `{"mapping": {
+        "@merge(` + strategy + `)": ["=$.defaults", "=$.customer", {"source": "api"}]
+    }
+}`
+	}
+
+	result, err := applyObjectMapping(t, mapping("override"), values)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "bob", "tags": []interface{}{"b"}, "address": map[string]interface{}{"city": "y", "zip": "1"}, "age": nil, "source": "api"}, result)
+
+	result, err = applyObjectMapping(t, mapping("keep"), values)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "unknown", "tags": []interface{}{"a"}, "address": map[string]interface{}{"city": "x", "zip": "1"}, "age": nil, "source": "api"}, result)
+
+	result, err = applyObjectMapping(t, mapping("append"), values)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, result.(map[string]interface{})["tags"])
+
+	_, err = applyObjectMapping(t, mapping("error"), values)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "merge conflict at 'address.city'")
+
+	// the merged values are not modified
+	assert.Equal(t, "unknown", values["defaults"].(map[string]interface{})["name"])
+	assert.Equal(t, []interface{}{"a"}, values["defaults"].(map[string]interface{})["tags"])
+
+	_, err = applyObjectMapping(t, mapping("other"), values)
+	assert.NotNil(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	values := map[string]interface{}{
+		"customer": map[string]interface{}{
+			"name":     "bob",
+			"password": "secret",
+			"address":  map[string]interface{}{"city": "y", "zip": "1"},
+			"cards":    []interface{}{map[string]interface{}{"type": "visa", "number": "4111"}},
+			"a.b":      1,
+			"phones":   []interface{}{"1", "2", "3"},
+		},
+	}
+
+	mappingValue := `{"mapping": {
+        "@delete($.customer)": ["password", "address.zip", "cards[*].number", "['a.b']", "phones[1]", "missing.field"]
+    }
+}`
+	result, err := applyObjectMapping(t, mappingValue, values)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":    "bob",
+		"address": map[string]interface{}{"city": "y"},
+		"cards":   []interface{}{map[string]interface{}{"type": "visa"}},
+		"phones":  []interface{}{"1", "3"},
+	}, result)
+
+	// the source is not modified
+	assert.Equal(t, "secret", values["customer"].(map[string]interface{})["password"])
+	assert.Len(t, values["customer"].(map[string]interface{})["phones"], 3)
+
+	_, err = applyObjectMapping(t, `{"mapping": {"@delete": ["a"]}}`, values)
+	assert.NotNil(t, err)
+}
+
+func TestAppend(t *testing.T) {
+	values := map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"sku": "a"}},
+		"sku":   "b",
+	}
+
+	mappingValue := `{"mapping": {
+        "@append($.items)": [{"sku": "=$.sku", "qty": 1}, "=$.sku"]
+    }
+}`
+	result, err := applyObjectMapping(t, mappingValue, values)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"sku": "a"}, map[string]interface{}{"sku": "b", "qty": 1.0}, "b"}, result)
+	assert.Len(t, values["items"], 1)
+
+	// a single element can be appended to a missing array
+	result, err = applyObjectMapping(t, `{"mapping": {"@append($.missing)": "=$.sku"}}`, map[string]interface{}{"missing": nil, "sku": "b"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"b"}, result)
+
+	_, err = applyObjectMapping(t, `{"mapping": {"@append($.sku)": "x"}}`, map[string]interface{}{"sku": map[string]interface{}{"a": 1}})
+	assert.NotNil(t, err)
+
+	// directives can be nested in object mappings
+	mappingValue = `{"mapping": {
+        "order": {
+            "items": {"@append($.items)": [{"sku": "=$.sku"}]}
+        }
+    }
+}`
+	result, err = applyObjectMapping(t, mappingValue, values)
+	assert.Nil(t, err)
+	assert.Len(t, result.(map[string]interface{})["order"].(map[string]interface{})["items"], 2)
+}
@@ -141,8 +141,15 @@ func NewObjectMapper(mappings interface{}, exprF expression.Factory) (expr expre
 						return nil, err
 					}
 				} else if strings.HasPrefix(mk, Merge) {
-					mergeFields := mv.([]interface{})
-					return createMergeMapper(mergeFields, exprF)
+					mergeFields, ok := mv.([]interface{})
+					if !ok {
+						return nil, fmt.Errorf("%s requires an array of mappings", Merge)
+					}
+					return createMergeMapper(mk, mergeFields, exprF)
+				} else if strings.HasPrefix(mk, Delete) {
+					return createDeleteMapper(mk, mv, exprF)
+				} else if strings.HasPrefix(mk, Append) {
+					return createAppendMapper(mk, mv, exprF)
 				} else {
 					objFields[mk], err = NewObjectMapper(mv, exprF)
 					if err != nil {
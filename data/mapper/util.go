@@ -29,3 +29,29 @@ func ConvertMappingValue(mappingType string, mappingValue interface{}) (interfac
 		return 0, fmt.Errorf("unsupported mapping type: %s", mappingType)
 	}
 }
+
+// deepCopy copies the objects and arrays of a value, so a mapper can modify the result without changing the
+// values it was evaluated from
+func deepCopy(val interface{}) interface{} {
+	switch t := val.(type) {
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			obj[k] = deepCopy(v)
+		}
+		return obj
+	case map[string]string:
+		obj := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			obj[k] = v
+		}
+		return obj
+	case []interface{}:
+		arr := make([]interface{}, len(t))
+		for i, v := range t {
+			arr[i] = deepCopy(v)
+		}
+		return arr
+	}
+	return val
+}
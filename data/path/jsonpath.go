@@ -0,0 +1,927 @@
+package path
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// JSONPath is a compiled JSONPath query, ex. $.store.book[?(@.price < 10)].title
+//
+// Supported are child (.name, ['name']), wildcard (*), recursive descent (..), index ([0], [-1]), union ([0,1],
+// ['a','b']), slice ([start:end:step]) and filter ([?(expr)]) selectors.  Filters support the operators
+// ==, !=, <, <=, >, >=, =~, &&, || and !, relative (@) and absolute ($) paths and number, string, bool and null
+// literals.
+type JSONPath struct {
+	expr     string
+	segments []*jpSegment
+	definite bool
+}
+
+// CompileJSONPath compiles a JSONPath query, the query can be used concurrently
+func CompileJSONPath(expr string) (*JSONPath, error) {
+	p := &jpParser{s: strings.TrimSpace(expr)}
+	if p.s == "" {
+		return nil, fmt.Errorf("invalid jsonpath '%s': empty path", expr)
+	}
+
+	if p.peek() == '$' {
+		p.pos++
+	} else if p.peek() != '.' && p.peek() != '[' {
+		return nil, fmt.Errorf("invalid jsonpath '%s': must start with '$'", expr)
+	}
+
+	segments, err := p.parseSegments()
+	if err == nil && !p.done() {
+		err = p.errorf("unexpected character '%c'", p.peek())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath '%s': %s", expr, err.Error())
+	}
+
+	jp := &JSONPath{expr: expr, segments: segments, definite: true}
+	for _, seg := range segments {
+		if !seg.isDefinite() {
+			jp.definite = false
+		}
+	}
+	return jp, nil
+}
+
+// String returns the query text
+func (jp *JSONPath) String() string {
+	return jp.expr
+}
+
+// IsDefinite returns whether the query can select at most one value, ex. $.store.book[0].title
+func (jp *JSONPath) IsDefinite() bool {
+	return jp.definite
+}
+
+// Query returns all the values selected by the query, strings and []byte are parsed as JSON
+func (jp *JSONPath) Query(value interface{}) ([]interface{}, error) {
+	root, err := toJSONValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return jp.query(root, root), nil
+}
+
+// Get returns the value selected by a definite query, nil if there is no such value, or an array of all the
+// values selected by any other query
+func (jp *JSONPath) Get(value interface{}) (interface{}, error) {
+	results, err := jp.Query(value)
+	if err != nil {
+		return nil, err
+	}
+	if jp.definite {
+		if len(results) == 0 {
+			return nil, nil
+		}
+		return results[0], nil
+	}
+	if results == nil {
+		results = []interface{}{}
+	}
+	return results, nil
+}
+
+func (jp *JSONPath) query(root, current interface{}) []interface{} {
+	nodes := []interface{}{current}
+	for _, seg := range jp.segments {
+		var next []interface{}
+		for _, node := range nodes {
+			if seg.recursive {
+				walk(node, func(v interface{}) {
+					next = seg.apply(root, v, next)
+				})
+			} else {
+				next = seg.apply(root, node, next)
+			}
+		}
+		nodes = next
+		if len(nodes) == 0 {
+			break
+		}
+	}
+	return nodes
+}
+
+// QueryJSONPath returns all the values selected by the JSONPath query
+func QueryJSONPath(value interface{}, expr string) ([]interface{}, error) {
+	jp, err := getJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	return jp.Query(value)
+}
+
+// GetJSONPathValue returns the value selected by a definite JSONPath query or an array of all the values
+// selected by any other query
+func GetJSONPathValue(value interface{}, expr string) (interface{}, error) {
+	jp, err := getJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	return jp.Get(value)
+}
+
+const jsonPathCacheSize = 512
+
+var (
+	jsonPathsMu sync.RWMutex
+	jsonPaths   = make(map[string]*JSONPath)
+)
+
+// getJSONPath returns the compiled query, queries are cached as they are usually constants in mappings
+func getJSONPath(expr string) (*JSONPath, error) {
+	jsonPathsMu.RLock()
+	jp, ok := jsonPaths[expr]
+	jsonPathsMu.RUnlock()
+	if ok {
+		return jp, nil
+	}
+
+	jp, err := CompileJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonPathsMu.Lock()
+	if len(jsonPaths) >= jsonPathCacheSize {
+		jsonPaths = make(map[string]*JSONPath)
+	}
+	jsonPaths[expr] = jp
+	jsonPathsMu.Unlock()
+	return jp, nil
+}
+
+func toJSONValue(value interface{}) (interface{}, error) {
+	var in interface{}
+	switch t := value.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(t), &in); err != nil {
+			return nil, err
+		}
+		return in, nil
+	case []byte:
+		if err := json.Unmarshal(t, &in); err != nil {
+			return nil, err
+		}
+		return in, nil
+	}
+	return value, nil
+}
+
+// -- [ Selectors ]
+
+type jpSegment struct {
+	recursive bool
+	selectors []jpSelector
+}
+
+func (s *jpSegment) isDefinite() bool {
+	if s.recursive || len(s.selectors) != 1 {
+		return false
+	}
+	switch s.selectors[0].(type) {
+	case jpName, jpIndex:
+		return true
+	}
+	return false
+}
+
+func (s *jpSegment) apply(root, node interface{}, out []interface{}) []interface{} {
+	for _, sel := range s.selectors {
+		out = sel.apply(root, node, out)
+	}
+	return out
+}
+
+type jpSelector interface {
+	apply(root, node interface{}, out []interface{}) []interface{}
+}
+
+type jpName string
+
+func (s jpName) apply(root, node interface{}, out []interface{}) []interface{} {
+	if v, ok := member(node, string(s)); ok {
+		out = append(out, v)
+	}
+	return out
+}
+
+type jpIndex int
+
+func (s jpIndex) apply(root, node interface{}, out []interface{}) []interface{} {
+	arr, ok := elements(node)
+	if !ok {
+		return out
+	}
+	idx := int(s)
+	if idx < 0 {
+		idx += len(arr)
+	}
+	if idx >= 0 && idx < len(arr) {
+		out = append(out, arr[idx])
+	}
+	return out
+}
+
+type jpWildcard struct{}
+
+func (jpWildcard) apply(root, node interface{}, out []interface{}) []interface{} {
+	return append(out, children(node)...)
+}
+
+type jpSlice struct {
+	start, end *int
+	step       int
+}
+
+func (s jpSlice) apply(root, node interface{}, out []interface{}) []interface{} {
+	arr, ok := elements(node)
+	if !ok || s.step == 0 {
+		return out
+	}
+
+	n := len(arr)
+	bound := func(i *int, def int) int {
+		if i == nil {
+			return def
+		}
+		v := *i
+		if v < 0 {
+			v += n
+		}
+		if v < -1 {
+			v = -1
+		} else if v > n {
+			v = n
+		}
+		return v
+	}
+
+	if s.step > 0 {
+		start, end := bound(s.start, 0), bound(s.end, n)
+		if start < 0 {
+			start = 0
+		}
+		for i := start; i < end; i += s.step {
+			out = append(out, arr[i])
+		}
+	} else {
+		start, end := bound(s.start, n-1), bound(s.end, -1)
+		if start >= n {
+			start = n - 1
+		}
+		for i := start; i > end; i += s.step {
+			out = append(out, arr[i])
+		}
+	}
+	return out
+}
+
+type jpFilter struct {
+	expr jpExpr
+}
+
+func (s jpFilter) apply(root, node interface{}, out []interface{}) []interface{} {
+	for _, child := range children(node) {
+		if truthy(s.expr, root, child) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+// -- [ Values ]
+
+func member(node interface{}, name string) (interface{}, bool) {
+	switch t := node.(type) {
+	case map[string]interface{}:
+		v, ok := t[name]
+		return v, ok
+	case map[string]string:
+		v, ok := t[name]
+		return v, ok
+	case nil:
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(node)
+	if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
+		v := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+		if v.IsValid() {
+			return v.Interface(), true
+		}
+		return nil, false
+	}
+	if rv.Kind() == reflect.Struct || (rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Struct) {
+		v, err := getFieldValueByName(node, name)
+		return v, err == nil
+	}
+	return nil, false
+}
+
+func elements(node interface{}) ([]interface{}, bool) {
+	switch t := node.(type) {
+	case []interface{}:
+		return t, true
+	case nil, string:
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(node)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		arr, err := toArray(node)
+		return arr, err == nil
+	}
+	return nil, false
+}
+
+// children returns the elements of an array or the values of an object, object values are ordered by key
+func children(node interface{}) []interface{} {
+	if arr, ok := elements(node); ok {
+		return arr
+	}
+
+	switch t := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]interface{}, len(keys))
+		for i, k := range keys {
+			values[i] = t[k]
+		}
+		return values
+	case nil:
+		return nil
+	}
+
+	rv := reflect.ValueOf(node)
+	if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		values := make([]interface{}, len(keys))
+		for i, k := range keys {
+			values[i] = rv.MapIndex(k).Interface()
+		}
+		return values
+	}
+	return nil
+}
+
+// walk visits the node and all its descendants, parents before children
+func walk(node interface{}, visit func(v interface{})) {
+	visit(node)
+	for _, child := range children(node) {
+		walk(child, visit)
+	}
+}
+
+// -- [ Filter expressions ]
+
+type jpExpr interface {
+	eval(root, current interface{}) jpValue
+}
+
+// jpValue is the result of a filter sub-expression, exists is false for a path that selects nothing
+type jpValue struct {
+	val    interface{}
+	exists bool
+}
+
+// truthy evaluates a filter condition, a path on its own tests that it exists whatever its value, ex. a false flag
+func truthy(e jpExpr, root, current interface{}) bool {
+	v := e.eval(root, current)
+	if !v.exists {
+		return false
+	}
+	if _, isPath := e.(*jpPathExpr); isPath {
+		return true
+	}
+	if b, ok := v.val.(bool); ok {
+		return b
+	}
+	return true
+}
+
+type jpLiteral struct {
+	val interface{}
+}
+
+func (e *jpLiteral) eval(root, current interface{}) jpValue {
+	return jpValue{val: e.val, exists: true}
+}
+
+type jpPathExpr struct {
+	relative bool
+	path     *JSONPath
+}
+
+func (e *jpPathExpr) eval(root, current interface{}) jpValue {
+	start := root
+	if e.relative {
+		start = current
+	}
+	results := e.path.query(root, start)
+	if len(results) == 0 {
+		return jpValue{}
+	}
+	return jpValue{val: results[0], exists: true}
+}
+
+type jpNot struct {
+	expr jpExpr
+}
+
+func (e *jpNot) eval(root, current interface{}) jpValue {
+	return jpValue{val: !truthy(e.expr, root, current), exists: true}
+}
+
+type jpLogical struct {
+	and         bool
+	left, right jpExpr
+}
+
+func (e *jpLogical) eval(root, current interface{}) jpValue {
+	l := truthy(e.left, root, current)
+	if l != e.and {
+		return jpValue{val: l, exists: true}
+	}
+	return jpValue{val: truthy(e.right, root, current), exists: true}
+}
+
+type jpCompare struct {
+	op          string
+	left, right jpExpr
+	re          *regexp.Regexp
+}
+
+func (e *jpCompare) eval(root, current interface{}) jpValue {
+	var r jpValue
+	if e.right != nil {
+		r = e.right.eval(root, current)
+	}
+	return jpValue{val: e.compare(e.left.eval(root, current), r), exists: true}
+}
+
+func (e *jpCompare) compare(l, r jpValue) bool {
+	switch e.op {
+	case "==":
+		return l.exists == r.exists && (!l.exists || jpEqual(l.val, r.val))
+	case "!=":
+		return !(l.exists == r.exists && (!l.exists || jpEqual(l.val, r.val)))
+	case "=~":
+		s, ok := l.val.(string)
+		return l.exists && ok && e.re.MatchString(s)
+	}
+
+	if !l.exists || !r.exists {
+		return false
+	}
+	if lf, ok := toFloat(l.val); ok {
+		rf, ok := toFloat(r.val)
+		if !ok {
+			return false
+		}
+		switch e.op {
+		case "<":
+			return lf < rf
+		case "<=":
+			return lf <= rf
+		case ">":
+			return lf > rf
+		default:
+			return lf >= rf
+		}
+	}
+	ls, ok := l.val.(string)
+	if !ok {
+		return false
+	}
+	rs, ok := r.val.(string)
+	if !ok {
+		return false
+	}
+	switch e.op {
+	case "<":
+		return ls < rs
+	case "<=":
+		return ls <= rs
+	case ">":
+		return ls > rs
+	default:
+		return ls >= rs
+	}
+}
+
+func jpEqual(l, r interface{}) bool {
+	if lf, ok := toFloat(l); ok {
+		rf, ok := toFloat(r)
+		return ok && lf == rf
+	}
+	return reflect.DeepEqual(l, r)
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch t := val.(type) {
+	case int:
+		return float64(t), true
+	case int32:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case float32:
+		return float64(t), true
+	case float64:
+		return t, true
+	case json.Number:
+		f, err := t.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// -- [ Parser ]
+
+type jpParser struct {
+	s   string
+	pos int
+}
+
+func (p *jpParser) done() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *jpParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *jpParser) peekAt(offset int) byte {
+	if p.pos+offset >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos+offset]
+}
+
+func (p *jpParser) skipSpaces() {
+	for !p.done() && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *jpParser) consume(token string) bool {
+	if strings.HasPrefix(p.s[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *jpParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// parseSegments parses segments until a character that cannot continue the path
+func (p *jpParser) parseSegments() ([]*jpSegment, error) {
+	var segments []*jpSegment
+	for !p.done() {
+		var seg *jpSegment
+		var err error
+
+		switch {
+		case p.consume(".."):
+			seg = &jpSegment{recursive: true}
+			if p.peek() == '[' {
+				seg.selectors, err = p.parseBracket()
+			} else {
+				seg.selectors, err = p.parseDotSelector()
+			}
+		case p.consume("."):
+			seg = &jpSegment{}
+			seg.selectors, err = p.parseDotSelector()
+		case p.peek() == '[':
+			seg = &jpSegment{}
+			seg.selectors, err = p.parseBracket()
+		default:
+			return segments, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+func (p *jpParser) parseDotSelector() ([]jpSelector, error) {
+	if p.consume("*") {
+		return []jpSelector{jpWildcard{}}, nil
+	}
+	start := p.pos
+	for !p.done() && isJPNameChar(p.s[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, p.errorf("expected a name")
+	}
+	return []jpSelector{jpName(p.s[start:p.pos])}, nil
+}
+
+func isJPNameChar(c byte) bool {
+	return !strings.ContainsRune(".[]()=!<>&|,'\" \t*?@$", rune(c))
+}
+
+func (p *jpParser) parseBracket() ([]jpSelector, error) {
+	p.pos++ // [
+	p.skipSpaces()
+
+	if p.consume("?") {
+		p.skipSpaces()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume("]") {
+			return nil, p.errorf("expected ']'")
+		}
+		return []jpSelector{jpFilter{expr: expr}}, nil
+	}
+
+	var selectors []jpSelector
+	for {
+		p.skipSpaces()
+		sel, err := p.parseBracketSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, sel)
+
+		p.skipSpaces()
+		if p.consume("]") {
+			return selectors, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (p *jpParser) parseBracketSelector() (jpSelector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return jpWildcard{}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return jpName(s), nil
+	}
+
+	var parts []*int
+	for {
+		p.skipSpaces()
+		n, ok := p.parseInt()
+		if ok {
+			parts = append(parts, &n)
+		} else {
+			parts = append(parts, nil)
+		}
+		p.skipSpaces()
+		if !p.consume(":") {
+			break
+		}
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] != nil:
+		return jpIndex(*parts[0]), nil
+	case len(parts) == 2 || len(parts) == 3:
+		slice := jpSlice{start: parts[0], end: parts[1], step: 1}
+		if len(parts) == 3 && parts[2] != nil {
+			slice.step = *parts[2]
+			if slice.step == 0 {
+				return nil, p.errorf("slice step cannot be 0")
+			}
+		}
+		return slice, nil
+	}
+	return nil, p.errorf("invalid selector")
+}
+
+func (p *jpParser) parseInt() (int, bool) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for !p.done() && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *jpParser) parseString() (string, error) {
+	quote := p.s[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for !p.done() {
+		c := p.s[p.pos]
+		p.pos++
+		switch {
+		case c == '\\' && !p.done():
+			b.WriteByte(p.s[p.pos])
+			p.pos++
+		case c == quote:
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *jpParser) parseOr() (jpExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !p.consume("||") {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &jpLogical{left: left, right: right}
+	}
+}
+
+func (p *jpParser) parseAnd() (jpExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !p.consume("&&") {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &jpLogical{and: true, left: left, right: right}
+	}
+}
+
+func (p *jpParser) parseUnary() (jpExpr, error) {
+	p.skipSpaces()
+	if p.peek() == '!' && p.peekAt(1) != '=' {
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &jpNot{expr: expr}, nil
+	}
+	return p.parseComparison()
+}
+
+var jpCmpOps = []string{"==", "!=", "<=", ">=", "=~", "<", ">"}
+
+func (p *jpParser) parseComparison() (jpExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	for _, op := range jpCmpOps {
+		if !p.consume(op) {
+			continue
+		}
+		p.skipSpaces()
+		if op == "=~" {
+			re, err := p.parseRegex()
+			if err != nil {
+				return nil, err
+			}
+			return &jpCompare{op: op, left: left, re: re}, nil
+		}
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &jpCompare{op: op, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *jpParser) parseRegex() (*regexp.Regexp, error) {
+	var pattern string
+	switch p.peek() {
+	case '/':
+		end := p.pos + 1
+		for end < len(p.s) && p.s[end] != '/' {
+			if p.s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.s) {
+			return nil, p.errorf("unterminated regular expression")
+		}
+		pattern = strings.ReplaceAll(p.s[p.pos+1:end], `\/`, "/")
+		p.pos = end + 1
+		if p.consume("i") {
+			pattern = "(?i)" + pattern
+		}
+	case '\'', '"':
+		var err error
+		pattern, err = p.parseString()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, p.errorf("expected a regular expression")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, p.errorf("invalid regular expression '%s': %s", pattern, err.Error())
+	}
+	return re, nil
+}
+
+func (p *jpParser) parseOperand() (jpExpr, error) {
+	p.skipSpaces()
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+	case c == '@' || c == '$':
+		p.pos++
+		segments, err := p.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		return &jpPathExpr{relative: c == '@', path: &JSONPath{segments: segments}}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return &jpLiteral{val: s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for !p.done() && strings.IndexByte("0123456789.eE+-", p.s[p.pos]) >= 0 {
+			p.pos++
+		}
+		f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf("invalid number '%s'", p.s[start:p.pos])
+		}
+		return &jpLiteral{val: f}, nil
+	case p.consume("true"):
+		return &jpLiteral{val: true}, nil
+	case p.consume("false"):
+		return &jpLiteral{val: false}, nil
+	case p.consume("null"):
+		return &jpLiteral{val: nil}, nil
+	}
+	return nil, p.errorf("expected a path or literal")
+}
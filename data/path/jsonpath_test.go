@@ -0,0 +1,129 @@
+package path
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const storeJSON = `{
+  "store": {
+    "book": [
+      {"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+      {"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+      {"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+      {"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+    ],
+    "bicycle": {"color": "red", "price": 19.95}
+  },
+  "expensive": 10
+}`
+
+func TestJSONPathQuery(t *testing.T) {
+	testcases := []struct {
+		path     string
+		expected []interface{}
+	}{
+		{`$.store.book[*].author`, []interface{}{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		{`$..author`, []interface{}{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		{`$.store.*.color`, []interface{}{"red"}},
+		{`$.store..price`, []interface{}{19.95, 8.95, 12.99, 8.99, 22.99}},
+		{`$..book[2].title`, []interface{}{"Moby Dick"}},
+		{`$..book[-1].title`, []interface{}{"The Lord of the Rings"}},
+		{`$..book[0,1].title`, []interface{}{"Sayings of the Century", "Sword of Honour"}},
+		{`$..book[:2].title`, []interface{}{"Sayings of the Century", "Sword of Honour"}},
+		{`$..book[1:4:2].title`, []interface{}{"Sword of Honour", "The Lord of the Rings"}},
+		{`$..book[::-1].price`, []interface{}{22.99, 8.99, 12.99, 8.95}},
+		{`$..book[?(@.isbn)].title`, []interface{}{"Moby Dick", "The Lord of the Rings"}},
+		{`$..book[?(!@.isbn)].title`, []interface{}{"Sayings of the Century", "Sword of Honour"}},
+		{`$..book[?(@.price < 10)].title`, []interface{}{"Sayings of the Century", "Moby Dick"}},
+		{`$..book[?(@.price > $.expensive)].title`, []interface{}{"Sword of Honour", "The Lord of the Rings"}},
+		{`$..book[?(@.category == 'fiction' && @.price < 20)].title`, []interface{}{"Sword of Honour", "Moby Dick"}},
+		{`$..book[?(@.price < 9 || @.author == "J. R. R. Tolkien")].price`, []interface{}{8.95, 8.99, 22.99}},
+		{`$..book[?(@.author =~ /^h.*/i)].title`, []interface{}{"Moby Dick"}},
+		{`$.store["bicycle"]['color', 'price']`, []interface{}{"red", 19.95}},
+		{`$.store.book[10].title`, nil},
+		{`$.missing[*]`, nil},
+	}
+
+	for _, tt := range testcases {
+		results, err := QueryJSONPath(storeJSON, tt.path)
+		assert.Nil(t, err, tt.path)
+		assert.Equal(t, tt.expected, results, tt.path)
+	}
+
+	// a path on its own tests that it exists, even when its value is false
+	flags := `{"items": [{"id": 1, "flag": false}, {"id": 2, "flag": true}, {"id": 3}]}`
+	results, err := QueryJSONPath(flags, `$.items[?(@.flag)].id`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1.0, 2.0}, results)
+
+	results, err = QueryJSONPath(flags, `$.items[?(!@.flag)].id`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{3.0}, results)
+
+	results, err = QueryJSONPath(flags, `$.items[?(@.flag == true)].id`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{2.0}, results)
+}
+
+func TestJSONPathGet(t *testing.T) {
+	value := map[string]interface{}{
+		"items":  []interface{}{map[string]interface{}{"id": 1}, map[string]interface{}{"id": 2}},
+		"params": map[string]string{"a": "b"},
+		"ids":    []int{4, 5, 6},
+	}
+
+	// definite paths return the value
+	v, err := GetJSONPathValue(value, `$.items[1].id`)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v)
+	v, err = GetJSONPathValue(value, `$.params.a`)
+	assert.Nil(t, err)
+	assert.Equal(t, "b", v)
+	v, err = GetJSONPathValue(value, `$.ids[-1]`)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, v)
+	v, err = GetJSONPathValue(value, `$.items[5].id`)
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	// other paths return an array, even for a single or no match
+	v, err = GetJSONPathValue(value, `$.items[*].id`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, 2}, v)
+	v, err = GetJSONPathValue(value, `$.items[?(@.id == 2)]`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": 2}}, v)
+	v, err = GetJSONPathValue(value, `$.items[?(@.id > 5)]`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{}, v)
+
+	// structs are supported
+	type item struct {
+		Name string `json:"name"`
+	}
+	v, err = GetJSONPathValue(map[string]interface{}{"items": []item{{Name: "a"}, {Name: "b"}}}, `$.items[*].name`)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, v)
+}
+
+func TestCompileJSONPath(t *testing.T) {
+	jp, err := CompileJSONPath(`$.a.b[0]`)
+	assert.Nil(t, err)
+	assert.True(t, jp.IsDefinite())
+
+	for _, path := range []string{`$..a`, `$.a[*]`, `$.a[0,1]`, `$.a[1:]`, `$.a[?(@.b)]`} {
+		jp, err = CompileJSONPath(path)
+		assert.Nil(t, err, path)
+		assert.False(t, jp.IsDefinite(), path)
+	}
+
+	for _, path := range []string{``, `a.b`, `$.`, `$[`, `$['a`, `$[1:2:0]`, `$[?(@.a ==)]`, `$[?(@.a =~ /[/)]`, `$.a]`} {
+		_, err = CompileJSONPath(path)
+		assert.NotNil(t, err, path)
+	}
+
+	_, err = QueryJSONPath(`{"a":`, `$.a`)
+	assert.NotNil(t, err)
+}
@@ -13,6 +13,23 @@ import (
 
 var skipMissing = config.IsMappingSkipMissingOn()
 
+// NotFoundError is returned when the value at a path does not exist, ex. a missing key or an array index
+// out of range
+type NotFoundError struct {
+	msg string
+}
+
+// Error implements error.Error
+func (e *NotFoundError) Error() string {
+	return e.msg
+}
+
+// IsNotFound checks if the error is, or wraps, a NotFoundError
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
+
 //todo consolidate and optimize code
 func GetValue(value interface{}, path string) (interface{}, error) {
 
@@ -117,7 +134,7 @@ func getFieldValueByName(object interface{}, name string) (interface{}, error) {
 		v := val.MapIndex(reflect.ValueOf(name))
 		return v.Interface(), nil
 	}
-	return nil, fmt.Errorf("unable to evaluate path: %s", name)
+	return nil, &NotFoundError{msg: "unable to evaluate path: " + name}
 }
 
 func GetJsonTag(t reflect.StructField) string {
@@ -237,8 +254,8 @@ func getSetArrayValue(obj interface{}, path string, value interface{}, set bool)
 		return nil, path, errors.New("Invalid array index: " + path[1:closeIdx])
 	}
 
-	if arrayIdx >= len(arrValue) {
-		return nil, path, errors.New("Array index '" + path + "' out of range.")
+	if arrayIdx < 0 || arrayIdx >= len(arrValue) {
+		return nil, path, &NotFoundError{msg: "Array index '" + path + "' out of range."}
 	}
 
 	if set && closeIdx == len(path)-1 {
@@ -266,7 +283,7 @@ func getSetObjValue(objValue map[string]interface{}, path string, value interfac
 		if skipMissing {
 			return nil, "", nil
 		}
-		return nil, "", errors.New("Invalid path '" + path + "'. path not found.")
+		return nil, "", &NotFoundError{msg: "Invalid path '" + path + "'. path not found."}
 	}
 
 	return val, path[npIdx:], nil
@@ -313,7 +330,7 @@ func getSetMapValue(objValue map[string]interface{}, path string, value interfac
 		if skipMissing {
 			return nil, "", nil
 		}
-		return nil, "", errors.New("Invalid path '" + path + "'. path not found.")
+		return nil, "", &NotFoundError{msg: "Invalid path '" + path + "'. path not found."}
 	}
 
 	return val, path[npIdx:], nil
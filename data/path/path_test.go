@@ -109,6 +109,21 @@ func TestGetValue(t *testing.T) {
 	assert.Nil(t, newVal)
 }
 
+func TestGetValueNotFound(t *testing.T) {
+	value := map[string]interface{}{"a": map[string]interface{}{"b": 1}, "arr": []interface{}{1}, "s": "text"}
+
+	for _, p := range []string{".a.x.y", `["a"]["x"].y`, ".arr[5]", ".a.b.c"} {
+		_, err := GetValue(value, p)
+		assert.NotNil(t, err, p)
+		assert.True(t, IsNotFound(err), p)
+	}
+
+	// a value that cannot be navigated is not a missing value
+	_, err := GetValue(value, ".s.x")
+	assert.NotNil(t, err)
+	assert.False(t, IsNotFound(err))
+}
+
 func TestSkipMissing(t *testing.T) {
 
 	source := `
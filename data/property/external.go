@@ -9,6 +9,7 @@ import (
 	"github.com/project-flogo/core/data/coerce"
 	"github.com/project-flogo/core/engine/secret"
 	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/redact"
 )
 
 var (
@@ -24,6 +25,13 @@ type ExternalResolver interface {
 	LookupValue(key string) (interface{}, bool)
 }
 
+// SourceReporter can be implemented by an ExternalResolver to report where the value of a property was resolved
+// from (e.g. a file)
+type SourceReporter interface {
+	// LookupSource returns the source of the value of the property and true if the property exists
+	LookupSource(key string) (string, bool)
+}
+
 // DEPRECATED
 func RegisterPropertyResolver(resolver ExternalResolver) error {
 	return RegisterExternalResolver(resolver)
@@ -73,6 +81,12 @@ func EnableExternalPropertyResolvers(resolverTypes string) error {
 }
 
 func ResolvePropertyExternally(propertyName string) (interface{}, bool) {
+	value, _, resolved := resolvePropertyExternally(propertyName)
+	return value, resolved
+}
+
+// resolvePropertyExternally resolves the property using the enabled resolvers, returning the resolver that resolved it
+func resolvePropertyExternally(propertyName string) (interface{}, ExternalResolver, bool) {
 
 	for _, resolver := range EnabledResolvers {
 		// Use resolver
@@ -83,13 +97,14 @@ func ResolvePropertyExternally(propertyName string) (interface{}, bool) {
 				if strings.HasPrefix(strValue, "SECRET:") {
 					encodedValue := string(strValue[7:])
 					decodedValue, _ := secret.GetSecretValueHandler().DecodeValue(encodedValue)
-					return decodedValue, true
+					redact.Track(decodedValue)
+					return decodedValue, resolver, true
 				}
 			}
-			return value, true
+			return value, resolver, true
 		}
 	}
-	return nil, false
+	return nil, nil, false
 }
 
 func ExternalResolverProcessor(properties map[string]interface{}) error {
@@ -108,11 +123,22 @@ func ExternalResolverProcessor(properties map[string]interface{}) error {
 	}
 
 	for name := range properties {
-		newVal, found := ResolvePropertyExternally(name)
+		newVal, resolver, found := resolvePropertyExternally(name)
 
 		if !found {
 			logger.Warnf("Property '%s' could not be resolved using property resolver(s). Using default value from flogo.json.", name)
 		} else {
+			// every resolved property is reported, with its source when the resolver knows it
+			var source string
+			if reporter, ok := resolver.(SourceReporter); ok {
+				source, _ = reporter.LookupSource(name)
+			}
+			if source != "" {
+				logger.Infof("Property '%s' resolved using the '%s' resolver from '%s'", name, resolver.Name(), source)
+			} else {
+				logger.Infof("Property '%s' resolved using the '%s' resolver", name, resolver.Name())
+			}
+
 			// Get datatype of old value
 			dType, _ := data.GetType(properties[name])
 			if dType != data.TypeUnknown {
@@ -121,6 +147,7 @@ func ExternalResolverProcessor(properties map[string]interface{}) error {
 					properties[name] = coercedVal
 					continue
 				}
+				logger.Warnf("Property '%s' resolved value could not be coerced to %s: %s", name, dType, err.Error())
 			}
 			properties[name] = newVal
 		}
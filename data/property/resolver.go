@@ -1,8 +1,6 @@
 package property
 
 import (
-	"fmt"
-
 	"github.com/project-flogo/core/data"
 	"github.com/project-flogo/core/data/resolve"
 )
@@ -37,7 +35,7 @@ func (*Resolver) Resolve(scope data.Scope, item string, field string) (interface
 	manager := DefaultManager()
 	value, exists := manager.GetProperty(item) //should we add the path and reset it to ""
 	if !exists {
-		return nil, fmt.Errorf("failed to resolve Property: '%s', ensure that property is configured in the application", item)
+		return nil, resolve.NewNotFoundError("failed to resolve Property: '%s', ensure that property is configured in the application", item)
 	}
 
 	return value, nil
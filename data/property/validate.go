@@ -0,0 +1,197 @@
+package property
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+)
+
+// Constraints are the constraints the value of an app property is validated against, ex.
+//
+//	{
+//	  "name": "db.port",
+//	  "type": "int",
+//	  "value": 5432,
+//	  "required": true,
+//	  "min": 1,
+//	  "max": 65535,
+//	  "description": "The database port"
+//	}
+type Constraints struct {
+	// Required indicates the property must have a non-empty value
+	Required bool `json:"required,omitempty"`
+	// Allowed are the values the property can have
+	Allowed []interface{} `json:"allowed,omitempty"`
+	// Min is the minimum value of a number, or the minimum length of a string, array or object
+	Min *float64 `json:"min,omitempty"`
+	// Max is the maximum value of a number, or the maximum length of a string, array or object
+	Max *float64 `json:"max,omitempty"`
+	// Regex is the pattern the value must match
+	Regex string `json:"regex,omitempty"`
+	// Description describes the property, it is included in the validation report
+	Description string `json:"description,omitempty"`
+}
+
+// IsEmpty returns true if there are no constraints
+func (c *Constraints) IsEmpty() bool {
+	return c == nil || (!c.Required && len(c.Allowed) == 0 && c.Min == nil && c.Max == nil && c.Regex == "" && c.Description == "")
+}
+
+// Definition is the definition of an app property, its value is validated against its type and constraints
+type Definition struct {
+	Name        string
+	Type        data.Type
+	Constraints *Constraints
+}
+
+// InvalidProperty describes why the value of a property is invalid
+type InvalidProperty struct {
+	Name        string
+	Description string
+	Reason      string
+}
+
+func (p *InvalidProperty) String() string {
+	if p.Description != "" {
+		return fmt.Sprintf("'%s' (%s): %s", p.Name, p.Description, p.Reason)
+	}
+	return fmt.Sprintf("'%s': %s", p.Name, p.Reason)
+}
+
+// ValidationError reports all the invalid properties
+type ValidationError struct {
+	Invalid []*InvalidProperty
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d invalid app properties:", len(e.Invalid)))
+	for _, p := range e.Invalid {
+		sb.WriteString("\n  - ")
+		sb.WriteString(p.String())
+	}
+	return sb.String()
+}
+
+// Validate validates the properties against their definitions.  The value of a typed property is coerced to its
+// type.  A *ValidationError reporting every invalid property is returned if any property is invalid.
+func Validate(properties map[string]interface{}, defs []*Definition) error {
+	var invalid []*InvalidProperty
+
+	for _, def := range defs {
+		reason := validateProperty(properties, def)
+		if reason != "" {
+			p := &InvalidProperty{Name: def.Name, Reason: reason}
+			if def.Constraints != nil {
+				p.Description = def.Constraints.Description
+			}
+			invalid = append(invalid, p)
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Slice(invalid, func(i, j int) bool {
+			return invalid[i].Name < invalid[j].Name
+		})
+		return &ValidationError{Invalid: invalid}
+	}
+	return nil
+}
+
+func validateProperty(properties map[string]interface{}, def *Definition) string {
+	val, exists := properties[def.Name]
+	c := def.Constraints
+	if c == nil {
+		c = &Constraints{}
+	}
+
+	if !exists || val == nil || val == "" {
+		if c.Required {
+			return "a value is required"
+		}
+		return ""
+	}
+
+	switch def.Type {
+	case data.TypeUnknown, data.TypeAny, data.TypeConnection:
+	default:
+		coerced, err := coerce.ToType(val, def.Type)
+		if err != nil {
+			return fmt.Sprintf("value '%v' is not of type %s", val, def.Type)
+		}
+		val = coerced
+		properties[def.Name] = coerced
+	}
+
+	if len(c.Allowed) > 0 && !isAllowed(val, c.Allowed) {
+		return fmt.Sprintf("value '%v' is not one of the allowed values %v", val, c.Allowed)
+	}
+
+	if c.Min != nil || c.Max != nil {
+		size, isLength, ok := sizeOf(val)
+		if !ok {
+			return fmt.Sprintf("value '%v' cannot be compared to a minimum or maximum", val)
+		}
+		what := "value"
+		if isLength {
+			what = "length"
+		}
+		if c.Min != nil && size < *c.Min {
+			return fmt.Sprintf("%s %v is less than the minimum %v", what, size, *c.Min)
+		}
+		if c.Max != nil && size > *c.Max {
+			return fmt.Sprintf("%s %v is greater than the maximum %v", what, size, *c.Max)
+		}
+	}
+
+	if c.Regex != "" {
+		re, err := regexp.Compile(c.Regex)
+		if err != nil {
+			return fmt.Sprintf("invalid regex '%s': %s", c.Regex, err.Error())
+		}
+		s, err := coerce.ToString(val)
+		if err != nil || !re.MatchString(s) {
+			return fmt.Sprintf("value '%v' does not match '%s'", val, c.Regex)
+		}
+	}
+
+	return ""
+}
+
+func isAllowed(val interface{}, allowed []interface{}) bool {
+	s, err := coerce.ToString(val)
+	if err != nil {
+		return false
+	}
+	for _, a := range allowed {
+		if as, err := coerce.ToString(a); err == nil && as == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sizeOf returns the value of a number or the length of a string, array or object
+func sizeOf(val interface{}) (float64, bool, bool) {
+	if s, ok := val.(string); ok {
+		return float64(len([]rune(s))), true, true
+	}
+
+	switch reflect.ValueOf(val).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(reflect.ValueOf(val).Len()), true, true
+	case reflect.Bool:
+		return 0, false, false
+	}
+
+	f, err := coerce.ToFloat64(val)
+	if err != nil {
+		return 0, false, false
+	}
+	return f, false, true
+}
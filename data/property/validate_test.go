@@ -0,0 +1,53 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/project-flogo/core/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestValidate(t *testing.T) {
+	defs := []*Definition{
+		{Name: "port", Type: data.TypeInt, Constraints: &Constraints{Min: floatPtr(1), Max: floatPtr(65535)}},
+		{Name: "level", Type: data.TypeString, Constraints: &Constraints{Allowed: []interface{}{"debug", "info"}}},
+		{Name: "host", Type: data.TypeString, Constraints: &Constraints{Required: true, Regex: `^[a-z.]+$`, Max: floatPtr(20)}},
+		{Name: "timeout", Type: data.TypeFloat64},
+		{Name: "optional", Type: data.TypeInt, Constraints: &Constraints{Min: floatPtr(1)}},
+	}
+
+	properties := map[string]interface{}{"port": "8080", "level": "info", "host": "example.com", "timeout": 1.5}
+	err := Validate(properties, defs)
+	assert.Nil(t, err)
+	// values are coerced to the property type
+	assert.Equal(t, 8080, properties["port"])
+
+	properties = map[string]interface{}{"port": "70000", "level": "trace", "host": "", "timeout": "abc"}
+	err = Validate(properties, defs)
+	if assert.NotNil(t, err) {
+		verr, ok := err.(*ValidationError)
+		assert.True(t, ok)
+		assert.Len(t, verr.Invalid, 4)
+		assert.Equal(t, "4 invalid app properties:\n"+
+			"  - 'host': a value is required\n"+
+			"  - 'level': value 'trace' is not one of the allowed values [debug info]\n"+
+			"  - 'port': value 70000 is greater than the maximum 65535\n"+
+			"  - 'timeout': value 'abc' is not of type float64", err.Error())
+	}
+
+	properties = map[string]interface{}{"host": "Example.com", "level": "debug"}
+	err = Validate(properties, defs)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "'host': value 'Example.com' does not match '^[a-z.]+$'")
+	}
+
+	properties = map[string]interface{}{"host": "a.very.long.host.example.com"}
+	err = Validate(properties, []*Definition{{Name: "host", Type: data.TypeString, Constraints: &Constraints{Max: floatPtr(20), Description: "The db host"}}})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "'host' (The db host): length 28 is greater than the maximum 20")
+	}
+}
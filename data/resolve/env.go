@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/project-flogo/core/data"
@@ -16,12 +15,12 @@ func (*EnvResolver) GetResolverInfo() *ResolverInfo {
 	return envResolverInfo
 }
 
-//EnvResolver Environment Resolver $env[item]
+// EnvResolver Environment Resolver $env[item]
 func (*EnvResolver) Resolve(scope data.Scope, item string, field string) (interface{}, error) {
 	// Environment resolution
 	value, exists := os.LookupEnv(item)
 	if !exists {
-		err := fmt.Errorf("failed to resolve Environment Variable: '%s', ensure that variable is configured", item)
+		err := NewNotFoundError("failed to resolve Environment Variable: '%s', ensure that variable is configured", item)
 		return "", err
 	}
 
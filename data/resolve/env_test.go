@@ -25,5 +25,6 @@ func TestEnvResolver_Resolve(t *testing.T) {
 	env, _ := os.LookupEnv("NONEXISTANT_ENV_123")
 	v, err = resolver.Resolve(nil, "NONEXISTANT_ENV_123", "")
 	assert.NotNil(t, err)
+	assert.True(t, IsNotFound(err))
 	assert.Equal(t, env, v)
 }
@@ -1,8 +1,6 @@
 package resolve
 
 import (
-	"fmt"
-
 	"github.com/project-flogo/core/data"
 	"github.com/project-flogo/core/data/path"
 )
@@ -16,19 +14,19 @@ func (*LoopResolver) GetResolverInfo() *ResolverInfo {
 	return loopResolverInfo
 }
 
-//LoopResolver Loop Resolver $Loop[item]
+// LoopResolver Loop Resolver $Loop[item]
 func (*LoopResolver) Resolve(scope data.Scope, item string, field string) (interface{}, error) {
 	var value interface{}
 	var exist bool
 	if item == "" {
 		value, exist = scope.GetValue("_loop")
 		if !exist {
-			return nil, fmt.Errorf("failed to resolve current Loop: '%s', ensure that Loop is configured in the application", field)
+			return nil, NewNotFoundError("failed to resolve current Loop: '%s', ensure that Loop is configured in the application", field)
 		}
 	} else {
 		value, exist = scope.GetValue(item)
 		if !exist {
-			return nil, fmt.Errorf("failed to resolve Loop: '%s', ensure that Loop is configured in the application", item)
+			return nil, NewNotFoundError("failed to resolve Loop: '%s', ensure that Loop is configured in the application", item)
 		}
 	}
 	if field != "" {
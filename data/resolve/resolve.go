@@ -1,10 +1,12 @@
 package resolve
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/path"
 )
 
 // Resolver is for resolving a value for a specific environment or construct, ex. OS environment
@@ -37,6 +39,27 @@ type Resolution interface {
 	GetValue(scope data.Scope) (interface{}, error)
 }
 
+// NotFoundError is returned by a Resolver when the value to resolve does not exist
+type NotFoundError struct {
+	msg string
+}
+
+// NewNotFoundError creates a NotFoundError with the formatted message
+func NewNotFoundError(format string, a ...interface{}) error {
+	return &NotFoundError{msg: fmt.Sprintf(format, a...)}
+}
+
+// Error implements error.Error
+func (e *NotFoundError) Error() string {
+	return e.msg
+}
+
+// IsNotFound checks if the error is, or wraps, a NotFoundError or a path.NotFoundError
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound) || path.IsNotFound(err)
+}
+
 // NewResolverInfo creates a ResolverInfo object
 func NewResolverInfo(isStatic, usesItemFormat bool) *ResolverInfo {
 	return &ResolverInfo{isStatic: isStatic, usesItemFormat: usesItemFormat}
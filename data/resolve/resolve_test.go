@@ -1,8 +1,10 @@
 package resolve
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/project-flogo/core/data"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -170,3 +172,13 @@ func TestIsResolveExprWithExpr(t *testing.T) {
 	assert.False(t, isResolve)
 
 }
+
+func TestResolveNotFound(t *testing.T) {
+	scope := data.NewSimpleScope(map[string]interface{}{"a": 1}, nil)
+
+	_, err := GetBasicResolver().Resolve("$.b", scope)
+	assert.NotNil(t, err)
+	assert.True(t, IsNotFound(err))
+
+	assert.False(t, IsNotFound(errors.New("failed")))
+}
@@ -1,8 +1,6 @@
 package resolve
 
 import (
-	"fmt"
-
 	"github.com/project-flogo/core/data"
 )
 
@@ -15,12 +13,12 @@ func (*ScopeResolver) GetResolverInfo() *ResolverInfo {
 	return scopeResolverInfo
 }
 
-//ScopeResolver Scope Resolver $.
+// ScopeResolver Scope Resolver $.
 func (*ScopeResolver) Resolve(scope data.Scope, item string, field string) (interface{}, error) {
 	// Scope resolution
 	value, exists := scope.GetValue(field)
 	if !exists {
-		err := fmt.Errorf("failed to resolve variable: '%s' in scope", field)
+		err := NewNotFoundError("failed to resolve variable: '%s' in scope", field)
 		return "", err
 	}
 
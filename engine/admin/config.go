@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"os"
+	"strconv"
+)
+
+const (
+	EnvKeyAdminEnabled  = "FLOGO_ADMIN_ENABLED"
+	DefaultAdminEnabled = false
+	EnvKeyAdminPort     = "FLOGO_ADMIN_PORT"
+	DefaultAdminPort    = 7779
+	EnvKeyAdminHost     = "FLOGO_ADMIN_HOST"
+	DefaultAdminHost    = "127.0.0.1"
+	// EnvKeyAdminToken is the bearer token required by the endpoints that change the state of the engine
+	EnvKeyAdminToken = "FLOGO_ADMIN_TOKEN"
+)
+
+// Config is the configuration for the engine admin server
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host,omitempty"`
+	Port    int    `json:"port,omitempty"`
+	// Token is the bearer token required by the endpoints that change the state of the engine, it is only
+	// set via the environment so that it isn't kept in the engine config
+	Token string `json:"-"`
+}
+
+// Enabled indicates if the admin server has been enabled via the environment
+func Enabled() bool {
+	enabled := os.Getenv(EnvKeyAdminEnabled)
+	if len(enabled) == 0 {
+		return DefaultAdminEnabled
+	}
+	b, _ := strconv.ParseBool(enabled)
+	return b
+}
+
+// GetPort returns the port the admin server should listen on
+func GetPort() int {
+	port := DefaultAdminPort
+	portEnv := os.Getenv(EnvKeyAdminPort)
+	if len(portEnv) > 0 {
+		i, err := strconv.Atoi(portEnv)
+		if err == nil {
+			port = i
+		}
+	}
+	return port
+}
+
+// GetHost returns the host the admin server should listen on, it defaults to the loopback interface
+func GetHost() string {
+	host := os.Getenv(EnvKeyAdminHost)
+	if len(host) == 0 {
+		return DefaultAdminHost
+	}
+	return host
+}
+
+// NewConfigFromEnv creates a new admin Config, looks for environment variables to override default values
+func NewConfigFromEnv() *Config {
+	return &Config{Enabled: Enabled(), Host: GetHost(), Port: GetPort(), Token: os.Getenv(EnvKeyAdminToken)}
+}
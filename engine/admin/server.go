@@ -0,0 +1,309 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/app"
+	"github.com/project-flogo/core/engine/channels"
+	"github.com/project-flogo/core/engine/event"
+	"github.com/project-flogo/core/support/health"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/metrics"
+	"github.com/project-flogo/core/support/service"
+)
+
+const listenerName = "flogo-admin"
+
+// Server is an embedded http server that exposes management information about a running engine
+type Server struct {
+	config     *Config
+	flogoApp   *app.App
+	srvManager *service.Manager
+	logger     log.Logger
+
+	mux    *http.ServeMux
+	server *http.Server
+
+	mutex     sync.RWMutex
+	appStatus app.Status
+}
+
+// AppInfo is the information reported about the running app
+type AppInfo struct {
+	Name    string     `json:"name"`
+	Version string     `json:"version"`
+	Env     string     `json:"env,omitempty"`
+	Status  app.Status `json:"status,omitempty"`
+}
+
+// TriggerInfo is the information reported about a trigger
+type TriggerInfo struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewServer creates a new admin Server for the specified app
+func NewServer(config *Config, flogoApp *app.App, srvManager *service.Manager) *Server {
+
+	s := &Server{config: config, flogoApp: flogoApp, srvManager: srvManager}
+	s.logger = log.ChildLogger(log.RootLogger(), "admin")
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/app", s.handleApp)
+	s.mux.HandleFunc("/triggers", s.handleTriggers)
+	s.mux.HandleFunc("/triggers/pause", s.handlePause)
+	s.mux.HandleFunc("/triggers/resume", s.handleResume)
+	s.mux.HandleFunc("/services", s.handleServices)
+	s.mux.HandleFunc("/channels", s.handleChannels)
+	s.mux.Handle("/health/live", health.LiveHandler())
+	s.mux.Handle("/health/ready", health.ReadyHandler())
+	s.mux.Handle("/metrics", metrics.Handler())
+
+	return s
+}
+
+// Handle registers an additional handler for the given pattern on the admin server
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Handler returns the http.Handler that serves the admin endpoints
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Start implements managed.Managed.Start()
+func (s *Server) Start() error {
+
+	err := event.RegisterListener(listenerName, s, []string{app.AppEventType})
+	if err != nil {
+		return err
+	}
+
+	host := s.config.Host
+	if host == "" {
+		host = DefaultAdminHost
+	}
+	if s.config.Token == "" && !isLoopback(host) {
+		s.logger.Warnf("Admin server listening on '%s' without a token, set %s to protect the endpoints that change the state of the engine", host, EnvKeyAdminToken)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(s.config.Port))
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		event.UnRegisterListener(listenerName, []string{app.AppEventType})
+		return fmt.Errorf("unable to start admin server on '%s': %s", addr, err.Error())
+	}
+
+	s.server = &http.Server{Handler: s.mux}
+
+	go func() {
+		err := s.server.Serve(ln)
+		if err != nil && err != http.ErrServerClosed {
+			s.logger.Errorf("Admin server error: %s", err.Error())
+		}
+	}()
+
+	s.logger.Infof("Admin server listening on '%s'", ln.Addr().String())
+
+	return nil
+}
+
+// Stop implements managed.Managed.Stop()
+func (s *Server) Stop() error {
+
+	event.UnRegisterListener(listenerName, []string{app.AppEventType})
+
+	if s.server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	s.server = nil
+
+	return err
+}
+
+// HandleEvent implements event.Listener.HandleEvent, it tracks the current status of the app
+func (s *Server) HandleEvent(ctx *event.Context) error {
+	if ae, ok := ctx.GetEvent().(app.AppEvent); ok {
+		s.mutex.Lock()
+		s.appStatus = ae.AppStatus()
+		s.mutex.Unlock()
+	}
+	return nil
+}
+
+func (s *Server) handleApp(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	s.mutex.RLock()
+	status := s.appStatus
+	s.mutex.RUnlock()
+
+	info := &AppInfo{
+		Name:    fmt.Sprint(s.flogoApp.Name()),
+		Version: fmt.Sprint(s.flogoApp.Version()),
+		Env:     s.flogoApp.Env(),
+		Status:  status,
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+func (s *Server) handleTriggers(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	statuses := s.flogoApp.TriggerStatuses()
+	infos := make([]*TriggerInfo, 0, len(statuses))
+	for _, status := range statuses {
+		info := &TriggerInfo{Name: status.Name, Status: string(status.Status)}
+		if status.Error != nil {
+			info.Error = status.Error.Error()
+		}
+		infos = append(infos, info)
+	}
+
+	writeJSON(w, http.StatusOK, infos)
+}
+
+func (s *Server) handlePause(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) || !s.authorize(w, r) {
+		return
+	}
+
+	controller := app.GetEventFlowController()
+	if controller == nil {
+		writeError(w, http.StatusConflict, fmt.Errorf("event flow control not enabled, set %s=true to enable this feature", app.EnvKeyEnableFlowControl))
+		return
+	}
+
+	err := controller.StartControl()
+	if err != nil {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+
+	s.logger.Info("Triggers paused via admin server")
+	writeJSON(w, http.StatusOK, map[string]string{"status": "paused"})
+}
+
+func (s *Server) handleResume(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) || !s.authorize(w, r) {
+		return
+	}
+
+	controller := app.GetEventFlowController()
+	if controller == nil {
+		writeError(w, http.StatusConflict, fmt.Errorf("event flow control not enabled, set %s=true to enable this feature", app.EnvKeyEnableFlowControl))
+		return
+	}
+
+	err := controller.ReleaseControl()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.logger.Info("Triggers resumed via admin server")
+	writeJSON(w, http.StatusOK, map[string]string{"status": "resumed"})
+}
+
+func (s *Server) handleServices(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	var names []string
+	if s.srvManager != nil {
+		for _, srv := range s.srvManager.Services() {
+			names = append(names, srv.Name())
+		}
+	}
+	sort.Strings(names)
+
+	writeJSON(w, http.StatusOK, toNamed(names))
+}
+
+func (s *Server) handleChannels(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	names := channels.Names()
+	sort.Strings(names)
+
+	writeJSON(w, http.StatusOK, toNamed(names))
+}
+
+func toNamed(names []string) []map[string]string {
+	named := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		named = append(named, map[string]string{"name": name})
+	}
+	return named
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method '%s' not allowed", r.Method))
+		return false
+	}
+	return true
+}
+
+// authorize checks the bearer token of a request to an endpoint that changes the state of the engine, if a
+// token is configured
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) bool {
+	if s.config.Token == "" {
+		return true
+	}
+
+	auth := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if len(auth) > len(prefix) && strings.EqualFold(auth[:len(prefix)], prefix) &&
+		subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(s.config.Token)) == 1 {
+		return true
+	}
+
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeError(w, http.StatusUnauthorized, fmt.Errorf("a valid bearer token is required"))
+	return false
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
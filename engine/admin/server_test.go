@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/project-flogo/core/app"
+	"github.com/project-flogo/core/support/service"
+	"github.com/stretchr/testify/assert"
+)
+
+type testService struct {
+	name string
+}
+
+func (s *testService) Start() error {
+	return nil
+}
+
+func (s *testService) Stop() error {
+	return nil
+}
+
+func (s *testService) Name() string {
+	return s.name
+}
+
+func newTestServer(t *testing.T) *Server {
+	flogoApp, err := app.New(&app.Config{Name: "testApp", Version: "1.0.0"}, nil)
+	assert.Nil(t, err)
+
+	srvManager := service.NewServiceManager()
+	err = srvManager.RegisterService(&testService{name: "svc2"})
+	assert.Nil(t, err)
+	err = srvManager.RegisterService(&testService{name: "svc1"})
+	assert.Nil(t, err)
+
+	return NewServer(&Config{Enabled: true}, flogoApp, srvManager)
+}
+
+func TestAppInfo(t *testing.T) {
+	s := newTestServer(t)
+
+	s.appStatus = app.STARTED
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	info := &AppInfo{}
+	err := json.Unmarshal(rec.Body.Bytes(), info)
+	assert.Nil(t, err)
+	assert.Equal(t, "testApp", info.Name)
+	assert.Equal(t, "1.0.0", info.Version)
+	assert.Equal(t, app.Status(app.STARTED), info.Status)
+}
+
+func TestServices(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/services", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var services []map[string]string
+	err := json.Unmarshal(rec.Body.Bytes(), &services)
+	assert.Nil(t, err)
+	assert.Len(t, services, 2)
+	assert.Equal(t, "svc1", services[0]["name"])
+	assert.Equal(t, "svc2", services[1]["name"])
+}
+
+func TestPauseWithoutFlowControl(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/triggers/pause", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/triggers/pause", nil))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestPauseRequiresToken(t *testing.T) {
+	s := newTestServer(t)
+	s.config.Token = "t0ken"
+
+	for _, auth := range []string{"", "Bearer wrong", "t0ken", "Basic t0ken"} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/triggers/resume", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		s.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, auth)
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/triggers/pause", nil)
+	req.Header.Set("Authorization", "Bearer t0ken")
+	s.Handler().ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// read only endpoints do not require the token
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/triggers", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	config := NewConfigFromEnv()
+	assert.Equal(t, DefaultAdminHost, config.Host)
+	assert.Equal(t, DefaultAdminPort, config.Port)
+	assert.Equal(t, "", config.Token)
+
+	t.Setenv(EnvKeyAdminHost, "0.0.0.0")
+	t.Setenv(EnvKeyAdminToken, "t0ken")
+	config = NewConfigFromEnv()
+	assert.Equal(t, "0.0.0.0", config.Host)
+	assert.Equal(t, "t0ken", config.Token)
+}
@@ -14,6 +14,10 @@ import (
 
 var appName, appVersion string
 
+// fileAppConfig is the last app configuration loaded from the app config file, only an app created from it
+// is watched for changes
+var fileAppConfig *app.Config
+
 func init() {
 	if IsSchemaSupportEnabled() {
 		schema.Enable()
@@ -78,6 +82,9 @@ func LoadAppConfig(flogoJson string, compressed bool) (*app.Config, error) {
 
 	appName = appConfig.Name
 	appVersion = appConfig.Version
+	if flogoJson == "" {
+		fileAppConfig = appConfig
+	}
 
 	debugger.SetAppInfo(appName, appVersion)
 
@@ -43,6 +43,15 @@ func Count() int {
 	return len(channels)
 }
 
+// Names returns the names of all the channels
+func Names() []string {
+	names := make([]string, 0, len(channels))
+	for name := range channels {
+		names = append(names, name)
+	}
+	return names
+}
+
 // Get gets the named channel
 func Get(name string) Channel {
 	if ch, ok := channels[name]; ok {
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/project-flogo/core/engine/admin"
 	"github.com/project-flogo/core/support"
 )
 
@@ -25,6 +26,11 @@ type Config struct {
 	GenMock        bool                              `json:"genMock"`
 	OutputPath     string                            `json:"outputPath,omitempty"`
 	AppPath        string                            `json:"appPath"`
+	Admin          *admin.Config                     `json:"admin,omitempty"`
+	WatchAppConfig bool                              `json:"watchAppConfig,omitempty"`
+	DrainTimeout   string                            `json:"drainTimeout,omitempty"`
+
+	ExplainConditions bool `json:"explainConditions,omitempty"`
 }
 
 // ServiceConfig is the configuration for Engine Services
@@ -70,6 +76,10 @@ func LoadEngineConfig(engineJson string, compressed bool) (*Config, error) {
 	cfg := &Config{}
 	cfg.StopEngineOnError = StopEngineOnError()
 	cfg.RunnerType = GetRunnerType()
+	cfg.Admin = admin.NewConfigFromEnv()
+	cfg.WatchAppConfig = WatchAppConfig()
+	cfg.DrainTimeout = GetDrainTimeout()
+	cfg.ExplainConditions = ExplainConditions()
 
 	if jsonBytes != nil {
 		err := json.Unmarshal(jsonBytes, &cfg)
@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/project-flogo/core/app"
@@ -34,3 +36,26 @@ func TestNewEngineErrorNoAppVersion(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "no App version provided", err.Error())
 }
+
+func TestWatchAppConfigOnlyFromFile(t *testing.T) {
+	cfgJson := `{"name": "MyApp", "version": "1.0.0"}`
+	cfgPath := filepath.Join(t.TempDir(), "flogo.json")
+	err := os.WriteFile(cfgPath, []byte(cfgJson), 0644)
+	assert.Nil(t, err)
+
+	t.Setenv(EnvKeyAppConfigLocation, cfgPath)
+	t.Setenv(EnvKeyAppConfigWatch, "true")
+
+	appConfig, err := LoadAppConfig("", false)
+	assert.Nil(t, err)
+	e, err := New(appConfig)
+	assert.Nil(t, err)
+	assert.NotNil(t, e.(*engineImpl).configWatcher)
+
+	// an embedded app config is not replaced by the app config file
+	appConfig, err = LoadAppConfig(cfgJson, false)
+	assert.Nil(t, err)
+	e, err = New(appConfig)
+	assert.Nil(t, err)
+	assert.Nil(t, e.(*engineImpl).configWatcher)
+}
@@ -3,17 +3,22 @@ package engine
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/project-flogo/core/action"
 	"github.com/project-flogo/core/app"
 	"github.com/project-flogo/core/data/property"
+	"github.com/project-flogo/core/engine/admin"
 	"github.com/project-flogo/core/engine/channels"
 	"github.com/project-flogo/core/engine/runner"
 	"github.com/project-flogo/core/engine/secret"
+	"github.com/project-flogo/core/support/health"
 	"github.com/project-flogo/core/support/log"
 	"github.com/project-flogo/core/support/managed"
+	"github.com/project-flogo/core/support/metrics"
 	"github.com/project-flogo/core/support/service"
 	"github.com/project-flogo/core/support/trace"
+	"github.com/project-flogo/core/trigger"
 )
 
 // engineImpl is the type for the Default Engine Implementation
@@ -22,7 +27,12 @@ type engineImpl struct {
 	flogoApp       *app.App
 	actionRunner   action.Runner
 	serviceManager *service.Manager
+	adminServer    *admin.Server
+	configWatcher  *appConfigWatcher
+	metricsPub     *metrics.Publisher
 	logger         log.Logger
+
+	explainConditions bool
 }
 
 type Option func(*engineImpl) error
@@ -60,9 +70,18 @@ func New(appConfig *app.Config, options ...Option) (Engine, error) {
 		config := &Config{}
 		config.StopEngineOnError = StopEngineOnError()
 		config.RunnerType = GetRunnerType()
+		config.WatchAppConfig = WatchAppConfig()
+		config.DrainTimeout = GetDrainTimeout()
+		config.ExplainConditions = ExplainConditions()
 		engine.config = config
 	}
 
+	trigger.SetExplainConditions(engine.explainConditions || engine.config.ExplainConditions)
+
+	if engine.config.Admin == nil {
+		engine.config.Admin = admin.NewConfigFromEnv()
+	}
+
 	if engine.actionRunner == nil {
 		var actionRunner action.Runner
 
@@ -144,6 +163,22 @@ func New(appConfig *app.Config, options ...Option) (Engine, error) {
 
 	logger.Debugf("Creating app [ %s ] with version [ %s ]", appConfig.Name, appConfig.Version)
 	engine.flogoApp = flogoApp
+
+	if engine.config.Admin.Enabled {
+		engine.adminServer = admin.NewServer(engine.config.Admin, flogoApp, engine.serviceManager)
+	}
+
+	if len(metrics.Exporters()) > 0 {
+		engine.metricsPub = metrics.NewPublisher(metrics.DefaultRegistry(), GetMetricsExportInterval())
+	}
+
+	if engine.config.WatchAppConfig {
+		if appConfig == fileAppConfig {
+			engine.configWatcher = newAppConfigWatcher(GetFlogoAppConfigPath(), GetAppConfigWatchInterval(), flogoApp, logger)
+		} else {
+			logger.Warnf("App configuration is not watched for changes, the app was not loaded from '%s'", GetFlogoAppConfigPath())
+		}
+	}
 	return engine, nil
 }
 
@@ -161,6 +196,15 @@ func DebugMode(debugMode bool, mockFile string, genMock bool, outputPath string,
 	}
 }
 
+// ExplainConditionsOption enables logging an explanation of the evaluation of handler conditions that are not
+// true or fail
+func ExplainConditionsOption(enabled bool) func(*engineImpl) error {
+	return func(e *engineImpl) error {
+		e.explainConditions = enabled
+		return nil
+	}
+}
+
 func ConfigOption(engineJson string, compressed bool) func(*engineImpl) error {
 	return func(e *engineImpl) error {
 
@@ -188,6 +232,13 @@ func (e *engineImpl) Start() error {
 
 	logger.Info("Engine Starting...")
 
+	if e.adminServer != nil {
+		err := managed.Start("Admin Server", e.adminServer)
+		if err != nil {
+			return err
+		}
+	}
+
 	logger.Info("Starting Services...")
 
 	actionRunner := e.actionRunner.(interface{})
@@ -204,6 +255,19 @@ func (e *engineImpl) Start() error {
 		logger.Info("Started Services")
 	}
 
+	for _, svc := range e.serviceManager.Services() {
+		if checker, ok := svc.(health.Checker); ok {
+			err = health.Register("service:"+svc.Name(), checker, health.Readiness)
+			if err != nil {
+				logger.Warnf("Unable to register health check for service '%s': %s", svc.Name(), err.Error())
+			}
+		}
+	}
+
+	if e.metricsPub != nil {
+		_ = managed.Start("Metrics Publisher", e.metricsPub)
+	}
+
 	if len(managedServices) > 0 {
 		for _, mService := range managedServices {
 			err = mService.Start()
@@ -224,6 +288,10 @@ func (e *engineImpl) Start() error {
 	e.flogoApp.PostAppEvent(app.STARTED)
 	logger.Info("Application Started")
 
+	if e.configWatcher != nil {
+		_ = managed.Start("App Config Watcher", e.configWatcher)
+	}
+
 	if channels.Count() > 0 {
 		logger.Info("Starting Engine Channels...")
 		_ = channels.Start()
@@ -241,12 +309,25 @@ func (e *engineImpl) Stop() error {
 
 	logger.Info("Engine Stopping...")
 
+	if e.config.DrainTimeout != "" {
+		timeout, err := time.ParseDuration(e.config.DrainTimeout)
+		if err != nil {
+			logger.Errorf("Invalid drain timeout '%s' specified, it must suffix with time unit e.g. %ss", e.config.DrainTimeout, e.config.DrainTimeout)
+		} else if timeout > 0 {
+			e.flogoApp.Drain(timeout)
+		}
+	}
+
 	if channels.Count() > 0 {
 		logger.Info("Stopping Engine Channels...")
 		_ = channels.Stop()
 		logger.Info("Engine Channels Stopped...")
 	}
 
+	if e.configWatcher != nil {
+		_ = managed.Stop("App Config Watcher", e.configWatcher)
+	}
+
 	logger.Info("Stopping Application...")
 	e.flogoApp.PostAppEvent(app.STOPPING)
 	_ = e.flogoApp.Stop()
@@ -262,6 +343,10 @@ func (e *engineImpl) Stop() error {
 		_ = managed.Stop("ActionRunner", managedRunner)
 	}
 
+	for _, svc := range e.serviceManager.Services() {
+		health.Unregister("service:" + svc.Name())
+	}
+
 	err := e.serviceManager.Stop()
 
 	if err != nil {
@@ -279,6 +364,14 @@ func (e *engineImpl) Stop() error {
 		}
 	}
 
+	if e.metricsPub != nil {
+		_ = managed.Stop("Metrics Publisher", e.metricsPub)
+	}
+
+	if e.adminServer != nil {
+		_ = managed.Stop("Admin Server", e.adminServer)
+	}
+
 	logger.Info("Engine Stopped")
 	log.Sync()
 
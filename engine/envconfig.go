@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/project-flogo/core/app/propertyresolver"
 	"github.com/project-flogo/core/data/property"
@@ -31,6 +32,19 @@ const (
 	EnvKeyRunnerQueueSize  = "FLOGO_RUNNER_QUEUE_SIZE"
 	DefaultRunnerQueueSize = 50
 
+	EnvKeyAppConfigWatch          = "FLOGO_APP_CONFIG_WATCH"
+	DefaultAppConfigWatch         = false
+	EnvKeyAppConfigWatchInterval  = "FLOGO_APP_CONFIG_WATCH_INTERVAL"
+	DefaultAppConfigWatchInterval = 5 * time.Second
+
+	EnvKeyDrainTimeout = "FLOGO_ENGINE_DRAIN_TIMEOUT"
+
+	EnvKeyExplainConditions  = "FLOGO_EXPLAIN_CONDITIONS"
+	DefaultExplainConditions = false
+
+	EnvKeyMetricsExportInterval  = "FLOGO_METRICS_EXPORT_INTERVAL"
+	DefaultMetricsExportInterval = 60 * time.Second
+
 	EnvAppPropertyResolvers   = "FLOGO_APP_PROP_RESOLVERS"
 	EnvEnableSchemaSupport    = "FLOGO_SCHEMA_SUPPORT"
 	EnvEnableSchemaValidation = "FLOGO_SCHEMA_VALIDATION"
@@ -121,6 +135,58 @@ func StopEngineOnError() bool {
 	return b
 }
 
+// WatchAppConfig indicates if the app configuration file should be watched for changes
+func WatchAppConfig() bool {
+	watch := os.Getenv(EnvKeyAppConfigWatch)
+	if len(watch) == 0 {
+		return DefaultAppConfigWatch
+	}
+	b, _ := strconv.ParseBool(watch)
+	return b
+}
+
+// ExplainConditions indicates if the evaluation of handler conditions that are not true or fail should be logged
+func ExplainConditions() bool {
+	explain := os.Getenv(EnvKeyExplainConditions)
+	if len(explain) == 0 {
+		return DefaultExplainConditions
+	}
+	b, _ := strconv.ParseBool(explain)
+	return b
+}
+
+// GetAppConfigWatchInterval returns the interval at which the app configuration file is checked for changes
+func GetAppConfigWatchInterval() time.Duration {
+	interval := DefaultAppConfigWatchInterval
+	intervalEnv := os.Getenv(EnvKeyAppConfigWatchInterval)
+	if len(intervalEnv) > 0 {
+		d, err := time.ParseDuration(intervalEnv)
+		if err == nil && d > 0 {
+			interval = d
+		}
+	}
+	return interval
+}
+
+// GetDrainTimeout returns the maximum time to wait for in-flight events to complete when the engine
+// is stopped, an empty value disables draining
+func GetDrainTimeout() string {
+	return os.Getenv(EnvKeyDrainTimeout)
+}
+
+// GetMetricsExportInterval returns the interval at which metrics are pushed to the registered metrics exporters
+func GetMetricsExportInterval() time.Duration {
+	interval := DefaultMetricsExportInterval
+	intervalEnv := os.Getenv(EnvKeyMetricsExportInterval)
+	if len(intervalEnv) > 0 {
+		d, err := time.ParseDuration(intervalEnv)
+		if err == nil && d > 0 {
+			interval = d
+		}
+	}
+	return interval
+}
+
 func displayAppPropertyValueResolversHelp(logger log.Logger, resolvers []string) {
 	logger.Warn("Multiple property resolvers where defined without setting a priority order!")
 	logger.Infof("Set environment variable '%s' with a comma-separated list of resolvers to use (definition order is decreasing order of priority)", EnvAppPropertyResolvers)
@@ -128,6 +194,18 @@ func displayAppPropertyValueResolversHelp(logger log.Logger, resolvers []string)
 	logger.Warn("No property resolver will be used")
 }
 
+// builtinPropertyResolvers are the builtin property resolvers in decreasing order of priority
+var builtinPropertyResolvers = []string{propertyresolver.ResolverNameEnv, propertyresolver.ResolverNameDir, propertyresolver.ResolverNameFile, propertyresolver.ResolverNameJson}
+
+func isBuiltinPropertyResolver(name string) bool {
+	for _, resolver := range builtinPropertyResolvers {
+		if resolver == name {
+			return true
+		}
+	}
+	return false
+}
+
 func GetAppPropertyValueResolvers(logger log.Logger) string {
 	key := os.Getenv(EnvAppPropertyResolvers)
 	if len(key) > 0 {
@@ -145,13 +223,18 @@ func GetAppPropertyValueResolvers(logger log.Logger) string {
 		for resolver := range property.RegisteredResolvers {
 			return resolver
 		}
-	case 2, 3:
+	default:
 		var resolvers, builtinResolvers []string
 
 		for resolver := range property.RegisteredResolvers {
-			if resolver != propertyresolver.ResolverNameEnv && resolver != propertyresolver.ResolverNameJson {
+			if !isBuiltinPropertyResolver(resolver) {
 				resolvers = append(resolvers, resolver)
-			} else {
+			}
+		}
+
+		// force priority between the builtin resolvers
+		for _, resolver := range builtinPropertyResolvers {
+			if _, registered := property.RegisteredResolvers[resolver]; registered {
 				builtinResolvers = append(builtinResolvers, resolver)
 			}
 		}
@@ -162,23 +245,9 @@ func GetAppPropertyValueResolvers(logger log.Logger) string {
 			return ""
 		}
 
-		if len(builtinResolvers) == 2 { // force priority between the two builtin resolvers
-			builtinResolvers = []string{propertyresolver.ResolverNameEnv, propertyresolver.ResolverNameJson}
-		}
-
 		resolvers = append(resolvers, builtinResolvers...)
 
 		return strings.Join(resolvers[:], ",")
-	default: // multiple (excluding builtin) resolvers defined, do nothing and hint to enforce an order
-		var resolvers []string
-
-		for resolver := range property.RegisteredResolvers {
-			resolvers = append(resolvers, resolver)
-		}
-
-		displayAppPropertyValueResolversHelp(logger, resolvers)
-
-		return ""
 	}
 
 	return ""
@@ -1,5 +1,9 @@
 package event
 
+import (
+	"github.com/project-flogo/core/support/redact"
+)
+
 type Listener interface {
 	// Called when matching event occurs
 	HandleEvent(*Context) error
@@ -30,7 +34,8 @@ var eventQueue = make(chan *Context, GetEventQueueSize())
 // Puts event with given type and data on the channel
 func Post(eventType string, event interface{}) {
 	if publishEventsEnabled && publisherRunning && HasListener(eventType) {
-		evtCtx := &Context{event: event, eventType: eventType}
+		// secrets are masked before the event is handed to the listeners
+		evtCtx := &Context{event: redact.Value(event), eventType: eventType}
 		// Put event on the queue
 		eventQueue <- evtCtx
 	}
@@ -36,8 +36,10 @@ func stopPublisherRoutine() {
 	}
 
 	if !hasListeners {
-		// No more listeners. Stop go routine
+		// No more listeners. Stop go routine, the publisher has received the shutdown once the send
+		// completes so a listener registered right after starts a new one
 		shutdown <- true
+		publisherRunning = false
 	}
 }
 
@@ -45,10 +47,6 @@ func publishEvents() {
 
 	log.RootLogger().Infof("Starting event publisher")
 
-	defer func() {
-		publisherRunning = false
-	}()
-
 	for {
 		select {
 		case evtCtx := <-eventQueue:
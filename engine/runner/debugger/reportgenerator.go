@@ -11,6 +11,7 @@ import (
 
 	"github.com/project-flogo/core/engine/support"
 	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/redact"
 	"github.com/project-flogo/core/trigger"
 )
 
@@ -35,7 +36,7 @@ func GenerateMock(coverage *support.Coverage, outputPath string) {
 		flowRep.ActivityReport = append(flowRep.ActivityReport, &support.ActivityMock{
 			ActivityName: activity.ActivityName,
 			MockType:     1,
-			Mock:         activity.Outputs,
+			Mock:         redact.Value(activity.Outputs),
 		})
 
 	}
@@ -112,6 +113,8 @@ func GenerateReport(config *trigger.HandlerConfig, interceptors []*support.TaskI
 	fileName := strings.ReplaceAll(config.Name+"-"+instanceID+".json", "/", "-")
 	fileName = strings.ReplaceAll(fileName, "\\", "-")
 
+	redactReport(report)
+
 	finalReport.Report = report
 	op, err := json.MarshalIndent(finalReport, "", "    ")
 	if err != nil {
@@ -140,6 +143,56 @@ func GenerateReport(config *trigger.HandlerConfig, interceptors []*support.TaskI
 	}
 }
 
+// redactReport masks the secrets in the settings and data of the report
+func redactReport(report *support.Report) {
+	if !redact.Enabled() {
+		return
+	}
+
+	if report.Trigger != nil {
+		report.Trigger.Settings = redact.Map(report.Trigger.Settings)
+		report.Trigger.Handler.Input = redact.Map(report.Trigger.Handler.Input)
+		report.Trigger.Handler.Output = redact.Map(report.Trigger.Handler.Output)
+		report.Trigger.Handler.Error = redact.Map(report.Trigger.Handler.Error)
+	}
+	if report.Flows != nil {
+		redactFlowReport(report.Flows, make(map[*support.FlowReport]bool))
+	}
+}
+
+func redactFlowReport(flowReport *support.FlowReport, visited map[*support.FlowReport]bool) {
+	// sub flow reports can be shared
+	if visited[flowReport] {
+		return
+	}
+	visited[flowReport] = true
+
+	flowReport.Inputs = redact.Map(flowReport.Inputs)
+	flowReport.Outputs = redact.Map(flowReport.Outputs)
+	redactActivityReports(flowReport.ActivityReport)
+	redactActivityReports(flowReport.FlowErrorHandler.ActivityReport)
+
+	for name, subFlow := range flowReport.SubFlow {
+		if subFlowReport, ok := subFlow.(*support.FlowReport); ok {
+			redactFlowReport(subFlowReport, visited)
+		} else {
+			flowReport.SubFlow[name] = redact.Value(subFlow)
+		}
+	}
+}
+
+func redactActivityReports(activityReports []support.ActivityReport) {
+	for i := range activityReports {
+		activityReport := &activityReports[i]
+		activityReport.Inputs = redact.Map(activityReport.Inputs)
+		activityReport.Error = redact.Map(activityReport.Error)
+		if activityReport.Outputs != nil {
+			outputs := redact.Value(*activityReport.Outputs)
+			activityReport.Outputs = &outputs
+		}
+	}
+}
+
 func processTriggerHandlerError(coverage *support.Coverage, flowError map[string]interface{}) map[string]interface{} {
 	if coverage.FlowCoverage != nil {
 		return coverage.FlowCoverage.Error
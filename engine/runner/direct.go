@@ -6,10 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/project-flogo/core/action"
-	"github.com/project-flogo/core/activity"
 	"github.com/project-flogo/core/engine/runner/debugger"
 	coreSupport "github.com/project-flogo/core/engine/support"
 	"github.com/project-flogo/core/support"
+	"github.com/project-flogo/core/support/deadletter"
 	"github.com/project-flogo/core/support/log"
 	"github.com/project-flogo/core/trigger"
 	"os"
@@ -167,6 +167,14 @@ func (runner *DirectRunner) RunAction(ctx context.Context, act action.Action, in
 	}
 	trackDirectRunnerActions.AddRunner()
 	defer trackDirectRunnerActions.RemoveRunner()
+
+	inFlight := actionsInFlight.With(runnerTypeDirect)
+	inFlight.Inc()
+	defer func() {
+		inFlight.Dec()
+		recordActionRun(runnerTypeDirect, act, err)
+	}()
+
 	if syncAct, ok := act.(action.SyncAction); ok {
 		return syncAct.Run(ctx, inputs)
 	} else if asyncAct, ok := act.(action.AsyncAction); ok {
@@ -188,7 +196,7 @@ func (runner *DirectRunner) RunAction(ctx context.Context, act action.Action, in
 			if handler.resultData != nil {
 				outputs = handler.resultData
 			} else if handler.err != nil {
-				flowErrors = convertErrorToMap(handler.err)
+				flowErrors = deadletter.ErrorToMap(handler.err)
 			}
 			debugger.GenerateReport(handlerConfig, tasks, coverage, ro.InstanceId, inputs, outputs, flowErrors, runner.outputPath, runner.appPath)
 		}
@@ -203,33 +211,6 @@ func (runner *DirectRunner) RunAction(ctx context.Context, act action.Action, in
 	}
 }
 
-func convertErrorToMap(handlerErr error) map[string]interface{} {
-	if handlerErr == nil {
-		return nil
-	}
-
-	if activityErr, ok := handlerErr.(*activity.Error); ok {
-		return activityErrorToMap(activityErr)
-	}
-
-	return map[string]interface{}{
-		"error": handlerErr.Error(),
-		"type":  fmt.Sprintf("%T", handlerErr),
-	}
-}
-
-func activityErrorToMap(activityErr *activity.Error) map[string]interface{} {
-	return map[string]interface{}{
-		"error":        activityErr.Error(),
-		"activityName": activityErr.ActivityName(),
-		"errorCode":    activityErr.Code(),
-		"category":     activityErr.Category(),
-		"retriable":    activityErr.Retriable(),
-		"data":         activityErr.Data(),
-		"type":         fmt.Sprintf("%T", activityErr),
-	}
-}
-
 // SyncResultHandler simple result handler to use in synchronous case
 type SyncResultHandler struct {
 	done       chan bool
@@ -0,0 +1,30 @@
+package runner
+
+import (
+	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/support"
+	"github.com/project-flogo/core/support/metrics"
+)
+
+const (
+	runnerTypeDirect = "direct"
+	runnerTypePooled = "pooled"
+)
+
+var (
+	actionsRun      = metrics.NewCounter("flogo_runner_actions_total", "Number of actions run by the action runner", "runner", "status")
+	actionsInFlight = metrics.NewGauge("flogo_runner_actions_inflight", "Number of actions currently being run by the action runner", "runner")
+	actionErrors    = metrics.NewCounter("flogo_action_errors_total", "Number of action runs that resulted in an error", "action")
+	queueDepth      = metrics.NewGauge("flogo_runner_queue_depth", "Number of action requests waiting for a worker of the pooled runner")
+	workersBusy     = metrics.NewGauge("flogo_runner_workers_busy", "Number of workers of the pooled runner that are running an action")
+	workersTotal    = metrics.NewGauge("flogo_runner_workers", "Number of workers of the pooled runner")
+)
+
+func recordActionRun(runnerType string, act action.Action, err error) {
+	if err != nil {
+		actionsRun.With(runnerType, "failed").Inc()
+		actionErrors.With(support.GetRef(act)).Inc()
+	} else {
+		actionsRun.With(runnerType, "completed").Inc()
+	}
+}
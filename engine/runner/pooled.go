@@ -63,6 +63,7 @@ func (runner *PooledRunner) Start() error {
 			trackPooledRunnerActions.AddRunner()
 			worker.Start()
 		}
+		workersTotal.With().Set(float64(runner.numWorkers))
 
 		go func() {
 			for {
@@ -76,6 +77,7 @@ func (runner *PooledRunner) Start() error {
 
 						logger.Debug("Dispatching work request")
 						worker <- work
+						queueDepth.With().Dec()
 					}()
 				}
 			}
@@ -98,6 +100,7 @@ func (runner *PooledRunner) Stop() error {
 			runner.logger.Debug("Stopping worker", worker.ID)
 			worker.Stop()
 		}
+		workersTotal.With().Set(0)
 		// check if all actions done till shutdown waiting time
 		trackPooledRunnerActions.gracefulStop()
 	}
@@ -120,6 +123,7 @@ func (runner *PooledRunner) RunAction(ctx context.Context, act action.Action, in
 		actionData := &ActionData{context: ctx, action: act, inputs: inputs, arc: make(chan *ActionResult, 1)}
 		work := ActionWorkRequest{ReqType: RtRun, actionData: actionData}
 
+		queueDepth.With().Inc()
 		runner.workQueue <- work
 
 		if logger.DebugEnabled() {
@@ -132,6 +136,8 @@ func (runner *PooledRunner) RunAction(ctx context.Context, act action.Action, in
 			logger.Debugf("Action '%s' returned", support.GetRef(act))
 		}
 
+		recordActionRun(runnerTypePooled, act, reply.err)
+
 		return reply.results, reply.err
 	}
 
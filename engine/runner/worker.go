@@ -94,6 +94,7 @@ func (w ActionWorker) Start() {
 				case RtRun:
 
 					actionData := work.actionData
+					workersBusy.With().Inc()
 
 					handler := &AsyncResultHandler{result: make(chan *ActionResult), done: make(chan bool, 1)}
 
@@ -137,6 +138,7 @@ func (w ActionWorker) Start() {
 						actionData.arc <- &ActionResult{err: fmt.Errorf("unsupported action: %v", actionData.action)}
 					}
 
+					workersBusy.With().Dec()
 					logger.Debugf("Action-Worker-%d: Completed Request", w.ID)
 				}
 
@@ -3,6 +3,8 @@ package secret
 import (
 	"regexp"
 	"strings"
+
+	"github.com/project-flogo/core/support/redact"
 )
 
 func PreProcessConfig(appJson []byte) ([]byte, error) {
@@ -22,11 +24,12 @@ func PreProcessConfig(appJson []byte) ([]byte, error) {
 }
 
 func resolveSecretValue(encrypted string) (string, error) {
-	encodedValue := string(encrypted[7:])
+	encodedValue := string(encrypted[len(SecretPrefix):])
 	decodedValue, err := GetSecretValueHandler().DecodeValue(encodedValue)
 	if err != nil {
 		return "", err
 	}
+	redact.Track(decodedValue)
 	return decodedValue, nil
 }
 
@@ -34,7 +37,7 @@ func PropertyProcessor(properties map[string]interface{}) error {
 
 	for key, value := range properties {
 
-		if strVal, ok := value.(string); ok && strings.HasPrefix(strVal, "SECRET:") {
+		if strVal, ok := value.(string); ok && strings.HasPrefix(strVal, SecretPrefix) {
 
 			// Resolve secret value
 			newVal, err := resolveSecretValue(strVal)
@@ -0,0 +1,128 @@
+package secret
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultKeyAlias is the id used to retrieve the current default key from a secret store
+	DefaultKeyAlias = "default"
+
+	defaultStoreTimeout = 10 * time.Second
+)
+
+// HTTPProvider is a Provider that retrieves keys from a secret store over http.  A key is retrieved using
+// GET <url>/keys/<id>, which is expected to return {"id": "<id>", "key": "<key>"}, the default key is retrieved
+// using the 'default' alias and must return its actual id.  Retrieved keys are cached.
+type HTTPProvider struct {
+	Url string
+	// Token is sent as a bearer token if set
+	Token string
+	// Client is the http client used to call the secret store, if not set a client with a 10 second timeout is used
+	Client *http.Client
+
+	mutex     sync.Mutex
+	defaultId string
+	keys      map[string]string
+}
+
+type storeKey struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
+}
+
+func (p *HTTPProvider) DefaultKeyId() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.defaultId != "" {
+		return p.defaultId, nil
+	}
+
+	sk, err := p.fetch(DefaultKeyAlias)
+	if err != nil {
+		return "", err
+	}
+	if err := validateKeyId(sk.Id); err != nil {
+		return "", fmt.Errorf("secret store returned an invalid default key: %s", err.Error())
+	}
+	if sk.Id == DefaultKeyAlias {
+		return "", fmt.Errorf("secret store must return the actual id of the default key")
+	}
+	p.defaultId = sk.Id
+	p.keys[sk.Id] = sk.Key
+
+	return p.defaultId, nil
+}
+
+func (p *HTTPProvider) GetKey(id string) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if key, cached := p.keys[id]; cached {
+		return key, nil
+	}
+
+	sk, err := p.fetch(id)
+	if err != nil {
+		return "", err
+	}
+	p.keys[id] = sk.Key
+
+	return sk.Key, nil
+}
+
+// fetch retrieves a key from the secret store, the mutex must be held
+func (p *HTTPProvider) fetch(id string) (*storeKey, error) {
+	if p.keys == nil {
+		p.keys = make(map[string]string)
+	}
+
+	client := p.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultStoreTimeout}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(p.Url, "/")+"/keys/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve secret key '%s': %s", id, err.Error())
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("secret key '%s' not found in secret store", id)
+	default:
+		return nil, fmt.Errorf("unable to retrieve secret key '%s', secret store returned status %d", id, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve secret key '%s': %s", id, err.Error())
+	}
+	sk := &storeKey{}
+	if err := json.Unmarshal(body, sk); err != nil {
+		return nil, fmt.Errorf("unable to parse secret key '%s': %s", id, err.Error())
+	}
+	if sk.Key == "" {
+		return nil, fmt.Errorf("secret store returned an empty key for '%s'", id)
+	}
+	return sk, nil
+}
@@ -0,0 +1,88 @@
+package secret
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSecretStore(defaultId string, keys map[string]string, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.Header.Get("Authorization") != "Bearer mytoken" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/keys/")
+		if id == DefaultKeyAlias {
+			id = defaultId
+		}
+		key, exists := keys[id]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": id, "key": key})
+	}))
+}
+
+func TestHTTPProvider(t *testing.T) {
+	requests := 0
+	store := newTestSecretStore("k2", map[string]string{"k1": "mysecretkey1", "k2": "mysecretkey2"}, &requests)
+	defer store.Close()
+
+	handler := &ProviderSecretValueHandler{Provider: &HTTPProvider{Url: store.URL, Token: "mytoken"}}
+	encoded, err := handler.EncodeValue("mysecurepassword1")
+	assert.Nil(t, err)
+	id, _ := SplitKeyId(encoded)
+	assert.Equal(t, "k2", id)
+
+	decoded, err := handler.DecodeValue(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", decoded)
+	// the default key is cached
+	assert.Equal(t, 1, requests)
+
+	encoded, err = (&KeyBasedSecretValueHandler{Key: "mysecretkey1"}).EncodeValue("mysecurepassword2")
+	assert.Nil(t, err)
+	decoded, err = handler.DecodeValue("k1:" + encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword2", decoded)
+	assert.Equal(t, 2, requests)
+
+	_, err = handler.DecodeValue("k3:" + encoded)
+	assert.NotNil(t, err)
+
+	_, err = (&HTTPProvider{Url: store.URL, Token: "badtoken"}).GetKey("k1")
+	assert.NotNil(t, err)
+}
+
+func TestHTTPProviderEnv(t *testing.T) {
+	requests := 0
+	store := newTestSecretStore("k1", map[string]string{"k1": "mysecretkey1", "k2": "mysecretkey2"}, &requests)
+	defer store.Close()
+
+	_ = os.Setenv(EnvKeyDataSecretStoreUrl, store.URL)
+	_ = os.Setenv(EnvKeyDataSecretStoreToken, "mytoken")
+	_ = os.Setenv(EnvKeyDataSecretKeyId, "k2")
+	defer func() {
+		_ = os.Unsetenv(EnvKeyDataSecretStoreUrl)
+		_ = os.Unsetenv(EnvKeyDataSecretStoreToken)
+		_ = os.Unsetenv(EnvKeyDataSecretKeyId)
+		SetSecretValueHandler(nil)
+	}()
+
+	encoded, err := GetSecretValueHandler().EncodeValue("mysecurepassword1")
+	assert.Nil(t, err)
+	id, _ := SplitKeyId(encoded)
+	assert.Equal(t, "k2", id)
+
+	appJson, err := PreProcessConfig([]byte(`{"password": "SECRET:` + encoded + `"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"password": "mysecurepassword1"}`, string(appJson))
+}
@@ -0,0 +1,210 @@
+package secret
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// SecretPrefix is the prefix of an encrypted value in the app config or a property
+	SecretPrefix = "SECRET:"
+
+	keyIdSeparator = ":"
+)
+
+// Provider provides the named keys used to encrypt and decrypt secret values
+type Provider interface {
+	// DefaultKeyId returns the id of the key used to encrypt new values
+	DefaultKeyId() (string, error)
+	// GetKey returns the key with the specified id, an error is returned if the key does not exist
+	GetKey(id string) (string, error)
+}
+
+// ProviderSecretValueHandler is a secret value handler that uses the named keys of a Provider. The id of the key
+// used to encrypt a value is embedded in the encrypted value (i.e. <keyId>:<encrypted>) so that values encrypted with
+// different keys can be decrypted, values without a key id are decrypted using the default key.
+type ProviderSecretValueHandler struct {
+	Provider Provider
+	// KeyId is the id of the key used to encrypt values, if not set the default key of the provider is used
+	KeyId string
+}
+
+func (h *ProviderSecretValueHandler) EncodeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unable to encode secret value of type %T", value)
+	}
+
+	id := h.KeyId
+	if id == "" {
+		var err error
+		id, err = h.Provider.DefaultKeyId()
+		if err != nil {
+			return "", err
+		}
+	}
+	key, err := h.getKey(id)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := encryptValue(key, text)
+	if err != nil {
+		return "", err
+	}
+	return id + keyIdSeparator + encrypted, nil
+}
+
+func (h *ProviderSecretValueHandler) DecodeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unable to decode secret value of type %T", value)
+	}
+
+	id, encrypted := SplitKeyId(text)
+	if id == "" {
+		var err error
+		id, err = h.Provider.DefaultKeyId()
+		if err != nil {
+			return "", err
+		}
+	}
+	key, err := h.getKey(id)
+	if err != nil {
+		return "", err
+	}
+	return decryptValue(key, encrypted)
+}
+
+func (h *ProviderSecretValueHandler) getKey(id string) ([]byte, error) {
+	key, err := h.Provider.GetKey(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	kBytes := sha256.Sum256([]byte(key))
+	return kBytes[:], nil
+}
+
+// SplitKeyId splits an encrypted value into the id of the key it was encrypted with and the encrypted data, the id
+// is empty if the value does not contain one
+func SplitKeyId(value string) (string, string) {
+	// the encrypted data is base64 encoded, so it never contains the separator
+	if idx := strings.Index(value, keyIdSeparator); idx > 0 {
+		return value[:idx], value[idx+1:]
+	}
+	return "", value
+}
+
+// Rotate decrypts a value using the old handler and encrypts it using the new handler, the SECRET: prefix is retained
+func Rotate(value string, oldHandler, newHandler SecretValueHandler) (string, error) {
+	prefixed := strings.HasPrefix(value, SecretPrefix)
+	if prefixed {
+		value = value[len(SecretPrefix):]
+	}
+
+	decoded, err := oldHandler.DecodeValue(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt value using the old key: %s", err.Error())
+	}
+	encoded, err := newHandler.EncodeValue(decoded)
+	if err != nil {
+		return "", fmt.Errorf("unable to encrypt value using the new key: %s", err.Error())
+	}
+
+	if prefixed {
+		return SecretPrefix + encoded, nil
+	}
+	return encoded, nil
+}
+
+// Keyring is a Provider with a fixed set of named keys, ex.
+//
+//	{
+//	  "default": "2024-06",
+//	  "keys": {
+//	    "2023-01": "myoldkey",
+//	    "2024-06": "mynewkey"
+//	  }
+//	}
+type Keyring struct {
+	Default string            `json:"default"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// NewKeyring creates a keyring with the specified keys, the default key is used to encrypt new values
+func NewKeyring(defaultId string, keys map[string]string) (*Keyring, error) {
+	keyring := &Keyring{Default: defaultId, Keys: keys}
+	if err := keyring.validate(); err != nil {
+		return nil, err
+	}
+	return keyring, nil
+}
+
+// LoadKeyring loads a keyring from a json file
+func LoadKeyring(file string) (*Keyring, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring '%s': %s", file, err.Error())
+	}
+
+	keyring := &Keyring{}
+	if err := json.Unmarshal(data, keyring); err != nil {
+		return nil, fmt.Errorf("unable to parse keyring '%s': %s", file, err.Error())
+	}
+	if err := keyring.validate(); err != nil {
+		return nil, fmt.Errorf("invalid keyring '%s': %s", file, err.Error())
+	}
+	return keyring, nil
+}
+
+func (k *Keyring) validate() error {
+	if len(k.Keys) == 0 {
+		return fmt.Errorf("keyring has no keys")
+	}
+	for id, key := range k.Keys {
+		if err := validateKeyId(id); err != nil {
+			return err
+		}
+		if key == "" {
+			return fmt.Errorf("key '%s' is empty", id)
+		}
+	}
+	if _, exists := k.Keys[k.Default]; !exists {
+		return fmt.Errorf("default key '%s' does not exist", k.Default)
+	}
+	return nil
+}
+
+func (k *Keyring) DefaultKeyId() (string, error) {
+	return k.Default, nil
+}
+
+func (k *Keyring) GetKey(id string) (string, error) {
+	key, exists := k.Keys[id]
+	if !exists {
+		return "", fmt.Errorf("secret key '%s' not found in keyring", id)
+	}
+	return key, nil
+}
+
+func validateKeyId(id string) error {
+	if id == "" {
+		return fmt.Errorf("key id cannot be empty")
+	}
+	if strings.ContainsAny(id, keyIdSeparator+"\"\\") {
+		return fmt.Errorf("key id '%s' cannot contain ':', '\"' or '\\'", id)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring(t *testing.T) {
+	keyring, err := NewKeyring("k1", map[string]string{"k1": "mysecretkey1", "k2": "mysecretkey2"})
+	assert.Nil(t, err)
+
+	handler := &ProviderSecretValueHandler{Provider: keyring}
+	encoded, err := handler.EncodeValue("mysecurepassword1")
+	assert.Nil(t, err)
+	id, _ := SplitKeyId(encoded)
+	assert.Equal(t, "k1", id)
+
+	handler2 := &ProviderSecretValueHandler{Provider: keyring, KeyId: "k2"}
+	encoded2, err := handler2.EncodeValue("mysecurepassword2")
+	assert.Nil(t, err)
+	id, _ = SplitKeyId(encoded2)
+	assert.Equal(t, "k2", id)
+
+	// the key is selected using the id in the value
+	decoded, err := handler.DecodeValue(encoded2)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword2", decoded)
+	decoded, err = handler2.DecodeValue(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", decoded)
+
+	// a value without a key id is decrypted using the default key
+	legacy, err := (&KeyBasedSecretValueHandler{Key: "mysecretkey1"}).EncodeValue("mysecurepassword3")
+	assert.Nil(t, err)
+	decoded, err = handler.DecodeValue(legacy)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword3", decoded)
+
+	_, err = handler.DecodeValue("k3:" + legacy)
+	assert.NotNil(t, err)
+
+	_, err = NewKeyring("k3", map[string]string{"k1": "mysecretkey1"})
+	assert.NotNil(t, err)
+	_, err = NewKeyring("a:b", map[string]string{"a:b": "mysecretkey1"})
+	assert.NotNil(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "keyring.json")
+	err := os.WriteFile(file, []byte(`{"default": "2024-06", "keys": {"2023-01": "myoldkey", "2024-06": "mynewkey"}}`), 0600)
+	assert.Nil(t, err)
+
+	_ = os.Setenv(EnvKeyDataSecretKeyring, file)
+	defer func() {
+		_ = os.Unsetenv(EnvKeyDataSecretKeyring)
+		SetSecretValueHandler(nil)
+	}()
+
+	encoded, err := GetSecretValueHandler().EncodeValue("mysecurepassword1")
+	assert.Nil(t, err)
+	id, _ := SplitKeyId(encoded)
+	assert.Equal(t, "2024-06", id)
+
+	props := map[string]interface{}{"password": SecretPrefix + encoded}
+	err = PropertyProcessor(props)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", props["password"])
+
+	SetSecretValueHandler(nil)
+	_ = os.Setenv(EnvKeyDataSecretKeyring, filepath.Join(dir, "missing.json"))
+	_, err = GetSecretValueHandler().EncodeValue("mysecurepassword1")
+	assert.NotNil(t, err)
+}
+
+func TestRotate(t *testing.T) {
+	oldHandler := &KeyBasedSecretValueHandler{Key: "myoldkey"}
+	encoded, err := oldHandler.EncodeValue("mysecurepassword1")
+	assert.Nil(t, err)
+
+	keyring, err := NewKeyring("2024-06", map[string]string{"2024-06": "mynewkey"})
+	assert.Nil(t, err)
+	newHandler := &ProviderSecretValueHandler{Provider: keyring}
+
+	rotated, err := Rotate(SecretPrefix+encoded, oldHandler, newHandler)
+	assert.Nil(t, err)
+	assert.Contains(t, rotated, SecretPrefix+"2024-06:")
+
+	decoded, err := newHandler.DecodeValue(rotated[len(SecretPrefix):])
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", decoded)
+
+	_, err = Rotate(rotated, oldHandler, newHandler)
+	assert.NotNil(t, err)
+}
+
+func TestKeyringProduction(t *testing.T) {
+	_ = os.Setenv(EnvKeyEnvName, "prod")
+	defer func() {
+		_ = os.Unsetenv(EnvKeyEnvName)
+	}()
+
+	keyring, err := NewKeyring("k1", map[string]string{"k1": defaultDataSecretKey})
+	assert.Nil(t, err)
+	_, err = (&ProviderSecretValueHandler{Provider: keyring}).EncodeValue("mysecurepassword1")
+	assert.Equal(t, ErrDefaultKeyInProduction, err)
+}
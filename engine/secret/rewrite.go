@@ -0,0 +1,252 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PropertyRewriter rewrites the value of a property, it returns false if the value is left as is
+type PropertyRewriter func(name string, value string) (string, bool, error)
+
+// EncryptValue encrypts a value using the configured secret value handler, the encrypted value has the SECRET: prefix
+func EncryptValue(value string) (string, error) {
+	encoded, err := GetSecretValueHandler().EncodeValue(value)
+	if err != nil {
+		return "", err
+	}
+	return SecretPrefix + encoded, nil
+}
+
+// DecryptValue decrypts a value using the configured secret value handler, the SECRET: prefix is optional
+func DecryptValue(value string) (string, error) {
+	return GetSecretValueHandler().DecodeValue(strings.TrimPrefix(value, SecretPrefix))
+}
+
+// Encrypter encrypts the specified properties using the handler, values that are already encrypted are left as is
+func Encrypter(handler SecretValueHandler, names ...string) PropertyRewriter {
+	selected := toSet(names)
+	return func(name string, value string) (string, bool, error) {
+		if !selected[name] || strings.HasPrefix(value, SecretPrefix) {
+			return value, false, nil
+		}
+		encoded, err := handler.EncodeValue(value)
+		if err != nil {
+			return "", false, fmt.Errorf("unable to encrypt property '%s': %s", name, err.Error())
+		}
+		return SecretPrefix + encoded, true, nil
+	}
+}
+
+// Decrypter decrypts the specified properties using the handler, if no properties are specified all encrypted
+// properties are decrypted
+func Decrypter(handler SecretValueHandler, names ...string) PropertyRewriter {
+	selected := toSet(names)
+	return func(name string, value string) (string, bool, error) {
+		if (len(selected) > 0 && !selected[name]) || !strings.HasPrefix(value, SecretPrefix) {
+			return value, false, nil
+		}
+		decoded, err := handler.DecodeValue(value[len(SecretPrefix):])
+		if err != nil {
+			return "", false, fmt.Errorf("unable to decrypt property '%s': %s", name, err.Error())
+		}
+		return decoded, true, nil
+	}
+}
+
+// Rotator re-encrypts all encrypted properties, decrypting them using the old handler and encrypting them using
+// the new handler
+func Rotator(oldHandler, newHandler SecretValueHandler) PropertyRewriter {
+	return func(name string, value string) (string, bool, error) {
+		if !strings.HasPrefix(value, SecretPrefix) {
+			return value, false, nil
+		}
+		rotated, err := Rotate(value, oldHandler, newHandler)
+		if err != nil {
+			return "", false, fmt.Errorf("unable to rotate property '%s': %s", name, err.Error())
+		}
+		return rotated, true, nil
+	}
+}
+
+// RewriteAppProperties rewrites the values of the properties section of an app config (flogo.json), the rest of
+// the app config is left as is. It returns the updated app config and the names of the rewritten properties.
+func RewriteAppProperties(appJson []byte, rewriter PropertyRewriter) ([]byte, []string, error) {
+	indent := detectIndent(appJson)
+	fields, err := decodeOrderedObject(appJson)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse app config: %s", err.Error())
+	}
+
+	var rewritten []string
+	for _, field := range fields {
+		if field.name != "properties" {
+			continue
+		}
+
+		var properties []map[string]interface{}
+		if err := unmarshalJson(field.value, &properties); err != nil {
+			return nil, nil, fmt.Errorf("unable to parse app properties: %s", err.Error())
+		}
+		for _, property := range properties {
+			name, _ := property["name"].(string)
+			value, ok := property["value"].(string)
+			if !ok {
+				continue
+			}
+			newValue, changed, err := rewriter(name, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if changed {
+				property["value"] = newValue
+				rewritten = append(rewritten, name)
+			}
+		}
+		if len(rewritten) > 0 {
+			field.value, err = marshalJson(properties, indent, indent)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if len(rewritten) == 0 {
+		return appJson, nil, nil
+	}
+
+	sort.Strings(rewritten)
+	return encodeOrderedObject(fields, indent), rewritten, nil
+}
+
+// RewritePropertyFile rewrites the values of an external property file (a json object of property names and values).
+// It returns the updated file and the names of the rewritten properties.
+func RewritePropertyFile(propJson []byte, rewriter PropertyRewriter) ([]byte, []string, error) {
+	var properties map[string]interface{}
+	if err := unmarshalJson(propJson, &properties); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse property file: %s", err.Error())
+	}
+
+	var rewritten []string
+	for name, v := range properties {
+		value, ok := v.(string)
+		if !ok {
+			continue
+		}
+		newValue, changed, err := rewriter(name, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if changed {
+			properties[name] = newValue
+			rewritten = append(rewritten, name)
+		}
+	}
+
+	if len(rewritten) == 0 {
+		return propJson, nil, nil
+	}
+
+	sort.Strings(rewritten)
+	updated, err := marshalJson(properties, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(updated, '\n'), rewritten, nil
+}
+
+type orderedField struct {
+	name  string
+	value json.RawMessage
+}
+
+// decodeOrderedObject decodes the fields of a json object, retaining their order and values as is
+func decodeOrderedObject(data []byte) ([]*orderedField, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if t, err := decoder.Token(); err != nil || t != json.Delim('{') {
+		return nil, fmt.Errorf("expected a json object")
+	}
+
+	var fields []*orderedField
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		field := &orderedField{name: t.(string)}
+		if err := decoder.Decode(&field.value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func encodeOrderedObject(fields []*orderedField, indent string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	for i, field := range fields {
+		name, _ := json.Marshal(field.name)
+		buf.WriteString(indent)
+		buf.Write(name)
+		buf.WriteString(": ")
+		buf.Write(field.value)
+		if i < len(fields)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// detectIndent returns the indentation of the first field of a json object, so the object is re-encoded as it was
+func detectIndent(data []byte) string {
+	start := bytes.IndexByte(data, '{')
+	if start < 0 {
+		return "  "
+	}
+	for _, line := range bytes.Split(data[start+1:], []byte("\n")) {
+		trimmed := bytes.TrimLeft(line, " \t")
+		if len(bytes.TrimSpace(trimmed)) == 0 {
+			continue
+		}
+		if indent := line[:len(line)-len(trimmed)]; len(indent) > 0 {
+			return string(indent)
+		}
+		break
+	}
+	return "  "
+}
+
+// unmarshalJson unmarshals json keeping numbers as is, so they are not changed when the json is re-encoded
+func unmarshalJson(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// marshalJson marshals indented json without escaping html characters
+func marshalJson(v interface{}, prefix, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent(prefix, indent)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
@@ -0,0 +1,111 @@
+package secret
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAppJson = `{
+    "name": "myapp",
+    "type": "flogo:app",
+    "properties": [
+        {
+            "name": "db.password",
+            "type": "string",
+            "value": "mysecurepassword1"
+        },
+        {
+            "name": "db.url",
+            "type": "string",
+            "value": "postgres://localhost:5432/db?a=1&b=2"
+        },
+        {
+            "name": "db.port",
+            "type": "int",
+            "value": 5432
+        }
+    ],
+    "triggers": []
+}`
+
+func TestEncryptDecryptValue(t *testing.T) {
+	defer func() {
+		SetSecretValueHandler(nil)
+	}()
+	SetSecretValueHandler(&KeyBasedSecretValueHandler{Key: "mysecretkey1"})
+
+	encrypted, err := EncryptValue("mysecurepassword1")
+	assert.Nil(t, err)
+	assert.Contains(t, encrypted, SecretPrefix)
+
+	decrypted, err := DecryptValue(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", decrypted)
+	decrypted, err = DecryptValue(encrypted[len(SecretPrefix):])
+	assert.Nil(t, err)
+	assert.Equal(t, "mysecurepassword1", decrypted)
+}
+
+func TestRewriteAppProperties(t *testing.T) {
+	handler := &KeyBasedSecretValueHandler{Key: "mysecretkey1"}
+
+	encrypted, names, err := RewriteAppProperties([]byte(testAppJson), Encrypter(handler, "db.password", "db.url", "db.port"))
+	assert.Nil(t, err)
+	// only string properties are encrypted
+	assert.Equal(t, []string{"db.password", "db.url"}, names)
+
+	app := make(map[string]interface{})
+	err = json.Unmarshal(encrypted, &app)
+	assert.Nil(t, err)
+	assert.Equal(t, "myapp", app["name"])
+	assert.Equal(t, []interface{}{}, app["triggers"])
+	props := app["properties"].([]interface{})
+	assert.Contains(t, props[0].(map[string]interface{})["value"], SecretPrefix)
+	assert.Equal(t, float64(5432), props[2].(map[string]interface{})["value"])
+
+	// encrypted values are not encrypted again
+	_, names, err = RewriteAppProperties(encrypted, Encrypter(handler, "db.password"))
+	assert.Nil(t, err)
+	assert.Nil(t, names)
+
+	decrypted, names, err := RewriteAppProperties(encrypted, Decrypter(handler))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"db.password", "db.url"}, names)
+	assert.Equal(t, testAppJson+"\n", string(decrypted))
+
+	_, _, err = RewriteAppProperties(encrypted, Decrypter(&KeyBasedSecretValueHandler{Key: "mysecretkey2"}, "db.port"))
+	assert.Nil(t, err)
+	_, _, err = RewriteAppProperties([]byte(`[]`), Decrypter(handler))
+	assert.NotNil(t, err)
+}
+
+func TestRewritePropertyFile(t *testing.T) {
+	oldHandler := &KeyBasedSecretValueHandler{Key: "mysecretkey1"}
+	keyring, err := NewKeyring("k2", map[string]string{"k2": "mysecretkey2"})
+	assert.Nil(t, err)
+	newHandler := &ProviderSecretValueHandler{Provider: keyring}
+
+	propJson := []byte(`{"db.password": "mysecurepassword1", "db.port": 5432}`)
+	encrypted, names, err := RewritePropertyFile(propJson, Encrypter(oldHandler, "db.password"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"db.password"}, names)
+
+	rotated, names, err := RewritePropertyFile(encrypted, Rotator(oldHandler, newHandler))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"db.password"}, names)
+
+	props := make(map[string]interface{})
+	err = json.Unmarshal(rotated, &props)
+	assert.Nil(t, err)
+	assert.Contains(t, props["db.password"], SecretPrefix+"k2:")
+	assert.Equal(t, float64(5432), props["db.port"])
+
+	decrypted, _, err := RewritePropertyFile(rotated, Decrypter(newHandler))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"db.password\": \"mysecurepassword1\",\n  \"db.port\": 5432\n}\n", string(decrypted))
+
+	_, _, err = RewritePropertyFile(rotated, Decrypter(oldHandler))
+	assert.NotNil(t, err)
+}
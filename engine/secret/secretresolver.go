@@ -10,13 +10,27 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 )
 
 const (
 	EnvKeyDataSecretKey  = "FLOGO_DATA_SECRET_KEY"
 	defaultDataSecretKey = "flogo"
+
+	// EnvKeyDataSecretKeyring is the path of a keyring file containing the named keys used for secret values
+	EnvKeyDataSecretKeyring = "FLOGO_DATA_SECRET_KEYRING"
+	// EnvKeyDataSecretStoreUrl is the url of a secret store the named keys are retrieved from
+	EnvKeyDataSecretStoreUrl = "FLOGO_DATA_SECRET_STORE_URL"
+	// EnvKeyDataSecretStoreToken is the bearer token used to authenticate with the secret store
+	EnvKeyDataSecretStoreToken = "FLOGO_DATA_SECRET_STORE_TOKEN"
+	// EnvKeyDataSecretKeyId is the id of the key used to encrypt secret values, if not set the default of the provider is used
+	EnvKeyDataSecretKeyId = "FLOGO_DATA_SECRET_KEY_ID"
+
+	EnvKeyEnvName = "FLOGO_ENV"
 )
 
+var ErrDefaultKeyInProduction = errors.New("the default data secret key cannot be used in production, set " + EnvKeyDataSecretKey + " or configure a keyring")
+
 var secretValueHandler SecretValueHandler
 
 // SecretValueDecoder defines method for decoding value
@@ -38,16 +52,68 @@ func GetDataSecretKey() string {
 	return defaultDataSecretKey
 }
 
-// Get secret value handler. If not already set by SetSecretValueHandler(), will return default KeyBasedSecretValueDecoder
-// where decoding key value is expected to be set through FLOGO_DATA_SECRET_KEY environment variable.
-// If key is not set, a default key value(github.com/project-flogo/core/config.DATA_SECRET_KEY_DEFAULT) will be used.
+// IsProduction returns true if FLOGO_ENV is set to production (or prod)
+func IsProduction() bool {
+	env := os.Getenv(EnvKeyEnvName)
+	return strings.EqualFold(env, "production") || strings.EqualFold(env, "prod")
+}
+
+// Get secret value handler. If not already set by SetSecretValueHandler(), will return the handler configured
+// through the environment, see NewSecretValueHandler.
 func GetSecretValueHandler() SecretValueHandler {
 	if secretValueHandler == nil {
-		secretValueHandler = &KeyBasedSecretValueHandler{Key: GetDataSecretKey()}
+		handler, err := NewSecretValueHandler()
+		if err != nil {
+			// the configuration error is reported when a value is encoded or decoded
+			return &errorSecretValueHandler{err: err}
+		}
+		secretValueHandler = handler
 	}
 	return secretValueHandler
 }
 
+// NewSecretValueHandler creates a secret value handler based on the environment.  If FLOGO_DATA_SECRET_KEYRING is set
+// the keys are loaded from the keyring file, if FLOGO_DATA_SECRET_STORE_URL is set the keys are retrieved from the
+// secret store, otherwise a KeyBasedSecretValueHandler is used where the key is expected to be set through
+// FLOGO_DATA_SECRET_KEY environment variable. If key is not set, a default key value will be used.
+func NewSecretValueHandler() (SecretValueHandler, error) {
+	var provider Provider
+
+	if keyringFile := os.Getenv(EnvKeyDataSecretKeyring); keyringFile != "" {
+		keyring, err := LoadKeyring(keyringFile)
+		if err != nil {
+			return nil, err
+		}
+		provider = keyring
+	} else if storeUrl := os.Getenv(EnvKeyDataSecretStoreUrl); storeUrl != "" {
+		provider = &HTTPProvider{Url: storeUrl, Token: os.Getenv(EnvKeyDataSecretStoreToken)}
+	} else {
+		return &KeyBasedSecretValueHandler{Key: GetDataSecretKey()}, nil
+	}
+
+	return &ProviderSecretValueHandler{Provider: provider, KeyId: os.Getenv(EnvKeyDataSecretKeyId)}, nil
+}
+
+// checkKey verifies that the key can be used in the current environment
+func checkKey(key string) error {
+	if key == defaultDataSecretKey && IsProduction() {
+		return ErrDefaultKeyInProduction
+	}
+	return nil
+}
+
+type errorSecretValueHandler struct {
+	err error
+}
+
+func (h *errorSecretValueHandler) EncodeValue(value interface{}) (string, error) {
+	return "", h.err
+}
+
+func (h *errorSecretValueHandler) DecodeValue(value interface{}) (string, error) {
+	return "", h.err
+}
+
 // A key based secret value decoder. Secret value encryption/decryption is based on SHA256
 // and uses implementation from https://gist.github.com/willshiao/f4b03650e5a82561a460b4a15789cfa1
 type KeyBasedSecretValueHandler struct {
@@ -58,6 +124,9 @@ type KeyBasedSecretValueHandler struct {
 func (defaultResolver *KeyBasedSecretValueHandler) DecodeValue(value interface{}) (string, error) {
 	if value != nil {
 		if defaultResolver.Key != "" {
+			if err := checkKey(defaultResolver.Key); err != nil {
+				return "", err
+			}
 			kBytes := sha256.Sum256([]byte(defaultResolver.Key))
 			return decryptValue(kBytes[:], value.(string))
 		}
@@ -69,6 +138,9 @@ func (defaultResolver *KeyBasedSecretValueHandler) DecodeValue(value interface{}
 func (defaultResolver *KeyBasedSecretValueHandler) EncodeValue(value interface{}) (string, error) {
 	if value != nil {
 		if defaultResolver.Key != "" {
+			if err := checkKey(defaultResolver.Key); err != nil {
+				return "", err
+			}
 			kBytes := sha256.Sum256([]byte(defaultResolver.Key))
 			return encryptValue(kBytes[:], value.(string))
 		}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/app"
+	"github.com/project-flogo/core/support/log"
+)
+
+// appConfigWatcher watches the app configuration file and applies changes to the running app
+type appConfigWatcher struct {
+	path     string
+	interval time.Duration
+	flogoApp *app.App
+	logger   log.Logger
+
+	modTime  time.Time
+	shutdown chan struct{}
+	done     sync.WaitGroup
+}
+
+func newAppConfigWatcher(path string, interval time.Duration, flogoApp *app.App, logger log.Logger) *appConfigWatcher {
+	return &appConfigWatcher{path: path, interval: interval, flogoApp: flogoApp, logger: logger}
+}
+
+// Start implements managed.Managed.Start()
+func (w *appConfigWatcher) Start() error {
+
+	info, err := os.Stat(w.path)
+	if err != nil {
+		w.logger.Warnf("Unable to watch app configuration '%s': %s", w.path, err.Error())
+		return nil
+	}
+	w.modTime = info.ModTime()
+
+	w.shutdown = make(chan struct{})
+	w.done.Add(1)
+
+	go func() {
+		defer w.done.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				w.check()
+			case <-w.shutdown:
+				return
+			}
+		}
+	}()
+
+	w.logger.Infof("Watching app configuration '%s' for changes", w.path)
+
+	return nil
+}
+
+// Stop implements managed.Managed.Stop()
+func (w *appConfigWatcher) Stop() error {
+	if w.shutdown != nil {
+		close(w.shutdown)
+		w.done.Wait()
+		w.shutdown = nil
+	}
+	return nil
+}
+
+func (w *appConfigWatcher) check() {
+
+	info, err := os.Stat(w.path)
+	if err != nil {
+		w.logger.Warnf("Unable to access app configuration '%s': %s", w.path, err.Error())
+		return
+	}
+
+	if !info.ModTime().After(w.modTime) {
+		return
+	}
+	w.modTime = info.ModTime()
+
+	w.logger.Infof("App configuration '%s' changed, reloading...", w.path)
+
+	appConfig, err := LoadAppConfig("", false)
+	if err != nil {
+		w.logger.Errorf("Unable to load app configuration '%s': %s", w.path, err.Error())
+		return
+	}
+
+	diff, err := w.flogoApp.ApplyConfig(appConfig)
+	if err != nil {
+		w.logger.Errorf("Unable to reload app configuration, changes [%s] have not been applied: %s", diff, err.Error())
+		return
+	}
+
+	if diff.IsEmpty() {
+		w.logger.Info("App configuration unchanged")
+	}
+}
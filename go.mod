@@ -7,6 +7,7 @@ require (
 	github.com/stretchr/testify v1.4.0
 	github.com/xeipuuv/gojsonschema v1.1.0
 	go.uber.org/zap v1.16.0
+	gopkg.in/yaml.v2 v2.2.2
 )
 
 require (
@@ -18,5 +19,4 @@ require (
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	go.uber.org/atomic v1.6.0 // indirect
 	go.uber.org/multierr v1.5.0 // indirect
-	gopkg.in/yaml.v2 v2.2.2 // indirect
 )
@@ -0,0 +1,44 @@
+package deadletter
+
+import (
+	"fmt"
+
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/engine/channels"
+)
+
+const (
+	SinkTypeChannel = "channel"
+
+	// SettingChannel is the name of the engine channel the channel sink publishes the dead letters to
+	SettingChannel = "channel"
+)
+
+// channelSink publishes the dead letters to an engine channel
+type channelSink struct {
+	name string
+}
+
+func newChannelSink(settings map[string]interface{}) (Sink, error) {
+	name, err := coerce.ToString(settings[SettingChannel])
+	if err != nil || name == "" {
+		return nil, fmt.Errorf("dead letter channel sink requires the '%s' setting", SettingChannel)
+	}
+
+	return &channelSink{name: name}, nil
+}
+
+// Write implements Sink.Write
+func (s *channelSink) Write(letter *Letter) error {
+	// the channel is looked up when used, since channels are created after the handlers
+	ch := channels.Get(s.name)
+	if ch == nil {
+		return fmt.Errorf("engine channel '%s' not found", s.name)
+	}
+
+	if !ch.PublishNoWait(letter) {
+		return fmt.Errorf("engine channel '%s' is full", s.name)
+	}
+
+	return nil
+}
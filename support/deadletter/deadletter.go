@@ -0,0 +1,125 @@
+package deadletter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/activity"
+)
+
+// Config is the dead-letter configuration of a handler
+type Config struct {
+	// Sink is the type of sink the dead letters are written to e.g. file, channel or a custom registered sink
+	Sink string `json:"sink"`
+	// Settings are the settings of the sink
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// Letter is an event that could not be handled
+type Letter struct {
+	Id      string                 `json:"id"`
+	Trigger string                 `json:"trigger"`
+	Handler string                 `json:"handler"`
+	Time    time.Time              `json:"time"`
+	Data    map[string]interface{} `json:"data"`
+	Error   map[string]interface{} `json:"error"`
+}
+
+// New creates a new Letter for the trigger data of the event that failed with the specified error
+func New(eventId, triggerId, handlerName string, data map[string]interface{}, err error) *Letter {
+	return &Letter{Id: eventId, Trigger: triggerId, Handler: handlerName, Time: time.Now().UTC(), Data: data, Error: ErrorToMap(err)}
+}
+
+// Sink receives dead letters
+type Sink interface {
+	// Write stores the dead letter
+	Write(letter *Letter) error
+}
+
+// Source is implemented by sinks whose dead letters can be read back to be replayed
+type Source interface {
+	// Read returns the dead letters of the specified handler
+	Read(triggerId, handlerName string) ([]*Letter, error)
+	// Delete removes the specified dead letters of the handler
+	Delete(triggerId, handlerName string, ids ...string) error
+}
+
+// SinkFactory creates a Sink from its settings
+type SinkFactory func(settings map[string]interface{}) (Sink, error)
+
+var (
+	sinkFactoriesMu sync.RWMutex
+	sinkFactories   = make(map[string]SinkFactory)
+)
+
+// RegisterSink registers a factory for a type of sink
+func RegisterSink(sinkType string, factory SinkFactory) error {
+
+	if sinkType == "" {
+		return fmt.Errorf("dead letter sink type must be specified")
+	}
+
+	if factory == nil {
+		return fmt.Errorf("cannot register 'nil' dead letter sink factory")
+	}
+
+	sinkFactoriesMu.Lock()
+	defer sinkFactoriesMu.Unlock()
+
+	if _, dup := sinkFactories[sinkType]; dup {
+		return fmt.Errorf("dead letter sink already registered: %s", sinkType)
+	}
+
+	sinkFactories[sinkType] = factory
+
+	return nil
+}
+
+// NewSink creates the Sink described by the configuration
+func NewSink(config *Config) (Sink, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	sinkFactoriesMu.RLock()
+	factory, exists := sinkFactories[config.Sink]
+	sinkFactoriesMu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("dead letter sink not registered: %s", config.Sink)
+	}
+
+	return factory(config.Settings)
+}
+
+// ErrorToMap converts an error to a map describing the error, it is also used for the errors of debugger reports
+func ErrorToMap(err error) map[string]interface{} {
+	if err == nil {
+		return nil
+	}
+
+	var activityErr *activity.Error
+	if errors.As(err, &activityErr) {
+		return map[string]interface{}{
+			"error":        activityErr.Error(),
+			"activityName": activityErr.ActivityName(),
+			"errorCode":    activityErr.Code(),
+			"category":     activityErr.Category(),
+			"retriable":    activityErr.Retriable(),
+			"data":         activityErr.Data(),
+			"type":         fmt.Sprintf("%T", activityErr),
+		}
+	}
+
+	return map[string]interface{}{
+		"error": err.Error(),
+		"type":  fmt.Sprintf("%T", err),
+	}
+}
+
+func init() {
+	_ = RegisterSink(SinkTypeFile, newFileSink)
+	_ = RegisterSink(SinkTypeChannel, newChannelSink)
+}
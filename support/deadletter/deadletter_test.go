@@ -0,0 +1,85 @@
+package deadletter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/project-flogo/core/activity"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSink struct {
+	letters []*Letter
+}
+
+func (s *testSink) Write(letter *Letter) error {
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+func TestRegisterSink(t *testing.T) {
+	err := RegisterSink("test", func(settings map[string]interface{}) (Sink, error) {
+		return &testSink{}, nil
+	})
+	assert.Nil(t, err)
+
+	err = RegisterSink("test", func(settings map[string]interface{}) (Sink, error) {
+		return &testSink{}, nil
+	})
+	assert.NotNil(t, err)
+
+	sink, err := NewSink(&Config{Sink: "test"})
+	assert.Nil(t, err)
+	assert.IsType(t, &testSink{}, sink)
+
+	_, err = NewSink(&Config{Sink: "unknown"})
+	assert.NotNil(t, err)
+}
+
+func TestErrorToMap(t *testing.T) {
+	assert.Nil(t, ErrorToMap(nil))
+
+	m := ErrorToMap(errors.New("failed"))
+	assert.Equal(t, "failed", m["error"])
+
+	m = ErrorToMap(activity.NewRetriableActivityError("timed out", "E1", activity.TimeoutError, nil))
+	assert.Equal(t, "timed out", m["error"])
+	assert.Equal(t, "E1", m["errorCode"])
+	assert.Equal(t, string(activity.TimeoutError), m["category"])
+	assert.Equal(t, true, m["retriable"])
+}
+
+func TestFileSink(t *testing.T) {
+	_, err := NewSink(&Config{Sink: SinkTypeFile})
+	assert.NotNil(t, err)
+
+	sink, err := NewFileSink(t.TempDir())
+	assert.Nil(t, err)
+
+	for _, id := range []string{"1", "2", "3"} {
+		err = sink.Write(New(id, "trg", "handler", map[string]interface{}{"id": id}, errors.New("failed")))
+		assert.Nil(t, err)
+	}
+	err = sink.Write(New("4", "trg", "other", nil, errors.New("failed")))
+	assert.Nil(t, err)
+
+	source := sink.(Source)
+	letters, err := source.Read("trg", "handler")
+	assert.Nil(t, err)
+	assert.Len(t, letters, 3)
+	assert.Equal(t, "2", letters[1].Data["id"])
+	assert.Equal(t, "failed", letters[1].Error["error"])
+
+	err = source.Delete("trg", "handler", "1", "3")
+	assert.Nil(t, err)
+	letters, err = source.Read("trg", "handler")
+	assert.Nil(t, err)
+	assert.Len(t, letters, 1)
+	assert.Equal(t, "2", letters[0].Id)
+
+	err = source.Delete("trg", "handler", "2")
+	assert.Nil(t, err)
+	letters, err = source.Read("trg", "handler")
+	assert.Nil(t, err)
+	assert.Empty(t, letters)
+}
@@ -0,0 +1,158 @@
+package deadletter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/project-flogo/core/data/coerce"
+)
+
+const (
+	SinkTypeFile = "file"
+
+	// SettingDir is the directory the file sink writes the dead letters to
+	SettingDir = "dir"
+)
+
+// fileSink writes the dead letters of each handler to a JSONL file in a directory
+type fileSink struct {
+	mutex sync.Mutex
+	dir   string
+}
+
+func newFileSink(settings map[string]interface{}) (Sink, error) {
+	dir, err := coerce.ToString(settings[SettingDir])
+	if err != nil || dir == "" {
+		return nil, fmt.Errorf("dead letter file sink requires the '%s' setting", SettingDir)
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create dead letter directory '%s': %s", dir, err.Error())
+	}
+
+	return &fileSink{dir: dir}, nil
+}
+
+// NewFileSink creates a Sink that writes the dead letters of each handler to a JSONL file in the directory
+func NewFileSink(dir string) (Sink, error) {
+	return newFileSink(map[string]interface{}{SettingDir: dir})
+}
+
+func (s *fileSink) fileName(triggerId, handlerName string) string {
+	name := strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(triggerId + "_" + handlerName)
+	return filepath.Join(s.dir, name+".jsonl")
+}
+
+// Write implements Sink.Write
+func (s *fileSink) Write(letter *Letter) error {
+	line, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	f, err := os.OpenFile(s.fileName(letter.Trigger, letter.Handler), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(append(line, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// Read implements Source.Read
+func (s *fileSink) Read(triggerId, handlerName string) ([]*Letter, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.read(s.fileName(triggerId, handlerName))
+}
+
+func (s *fileSink) read(fileName string) ([]*Letter, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var letters []*Letter
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		letter := &Letter{}
+		err = json.Unmarshal(line, letter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dead letter in '%s': %s", fileName, err.Error())
+		}
+		letters = append(letters, letter)
+	}
+
+	return letters, scanner.Err()
+}
+
+// Delete implements Source.Delete
+func (s *fileSink) Delete(triggerId, handlerName string, ids ...string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	fileName := s.fileName(triggerId, handlerName)
+	letters, err := s.read(fileName)
+	if err != nil {
+		return err
+	}
+
+	toDelete := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		toDelete[id] = true
+	}
+
+	var remaining []byte
+	for _, letter := range letters {
+		if toDelete[letter.Id] {
+			continue
+		}
+		line, err := json.Marshal(letter)
+		if err != nil {
+			return err
+		}
+		remaining = append(remaining, line...)
+		remaining = append(remaining, '\n')
+	}
+
+	if len(remaining) == 0 {
+		err = os.Remove(fileName)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// write to a temporary file first, so the dead letters are not lost if the write fails
+	tmpName := fileName + ".tmp"
+	err = os.WriteFile(tmpName, remaining, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, fileName)
+}
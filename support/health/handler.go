@@ -0,0 +1,31 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LiveHandler returns a http.Handler that reports the liveness state
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, Live(r.Context()))
+	})
+}
+
+// ReadyHandler returns a http.Handler that reports the readiness state
+func ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, Ready(r.Context()))
+	})
+}
+
+func writeReport(w http.ResponseWriter, report *Report) {
+	code := http.StatusOK
+	if report.Status != StatusUp {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
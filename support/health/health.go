@@ -0,0 +1,230 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status is the health status of a check or of the aggregated state
+type Status string
+
+const (
+	StatusUp   Status = "UP"
+	StatusDown Status = "DOWN"
+)
+
+// Kind is the kind of health check
+type Kind string
+
+const (
+	// Liveness checks indicate if the process is alive and should not be restarted
+	Liveness Kind = "liveness"
+	// Readiness checks indicate if the process is ready to take traffic
+	Readiness Kind = "readiness"
+
+	DefaultCheckTimeout = 5 * time.Second
+)
+
+// Checker is implemented by objects that can report their health e.g. triggers, services,
+// connection managers and resources
+type Checker interface {
+	// HealthCheck returns an error if the object is not healthy
+	HealthCheck(ctx context.Context) error
+}
+
+// CheckerFunc is an adapter to allow the use of an ordinary function as a Checker
+type CheckerFunc func(ctx context.Context) error
+
+// HealthCheck implements Checker.HealthCheck
+func (f CheckerFunc) HealthCheck(ctx context.Context) error {
+	return f(ctx)
+}
+
+// CheckResult is the result of an individual health check
+type CheckResult struct {
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the aggregated health state
+type Report struct {
+	Status Status                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks,omitempty"`
+}
+
+type registeredCheck struct {
+	checker Checker
+	kind    Kind
+}
+
+var (
+	lock   = &sync.RWMutex{}
+	checks = make(map[string]*registeredCheck)
+	live   = true
+	ready  = false
+)
+
+// Register registers a named health check of the specified kind
+func Register(name string, checker Checker, kind Kind) error {
+
+	if name == "" {
+		return fmt.Errorf("health check name must be specified")
+	}
+
+	if checker == nil {
+		return fmt.Errorf("cannot register 'nil' health check")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, dup := checks[name]; dup {
+		return fmt.Errorf("health check already registered: %s", name)
+	}
+
+	checks[name] = &registeredCheck{checker: checker, kind: kind}
+
+	return nil
+}
+
+// Unregister removes the named health check
+func Unregister(name string) {
+	lock.Lock()
+	delete(checks, name)
+	lock.Unlock()
+}
+
+// Names returns the names of the registered health checks of the specified kind
+func Names(kind Kind) []string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	var names []string
+	for name, check := range checks {
+		if check.kind == kind {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SetLive sets the overall liveness state
+func SetLive(isLive bool) {
+	lock.Lock()
+	live = isLive
+	lock.Unlock()
+}
+
+// SetReady sets the overall readiness state
+func SetReady(isReady bool) {
+	lock.Lock()
+	ready = isReady
+	lock.Unlock()
+}
+
+// Live runs the liveness checks and returns the aggregated liveness state
+func Live(ctx context.Context) *Report {
+	lock.RLock()
+	isLive := live
+	lock.RUnlock()
+
+	return evaluate(ctx, Liveness, isLive)
+}
+
+// Ready runs the readiness checks and returns the aggregated readiness state
+func Ready(ctx context.Context) *Report {
+	lock.RLock()
+	isReady := ready
+	lock.RUnlock()
+
+	return evaluate(ctx, Readiness, isReady)
+}
+
+// IsLive indicates if the process is alive
+func IsLive(ctx context.Context) bool {
+	return Live(ctx).Status == StatusUp
+}
+
+// IsReady indicates if the process is ready to take traffic
+func IsReady(ctx context.Context) bool {
+	return Ready(ctx).Status == StatusUp
+}
+
+func evaluate(ctx context.Context, kind Kind, up bool) *Report {
+
+	lock.RLock()
+	toRun := make(map[string]Checker)
+	for name, check := range checks {
+		if check.kind == kind {
+			toRun[name] = check.checker
+		}
+	}
+	lock.RUnlock()
+
+	report := &Report{Status: StatusUp, Checks: make(map[string]*CheckResult, len(toRun))}
+	if !up {
+		report.Status = StatusDown
+	}
+
+	if len(toRun) == 0 {
+		return report
+	}
+
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultCheckTimeout)
+		defer cancel()
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+
+	for name, checker := range toRun {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+
+			result := &CheckResult{Status: StatusUp}
+			if err := runCheck(ctx, checker); err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+
+			mutex.Lock()
+			report.Checks[name] = result
+			if result.Status == StatusDown {
+				report.Status = StatusDown
+			}
+			mutex.Unlock()
+		}(name, checker)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func runCheck(ctx context.Context, checker Checker) error {
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("health check panic: %v", r)
+			}
+		}()
+		done <- checker.HealthCheck(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("health check timed out: %s", ctx.Err().Error())
+	}
+}
@@ -0,0 +1,83 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	defer Unregister("test")
+
+	err := Register("test", CheckerFunc(func(ctx context.Context) error { return nil }), Readiness)
+	assert.Nil(t, err)
+
+	err = Register("test", CheckerFunc(func(ctx context.Context) error { return nil }), Readiness)
+	assert.NotNil(t, err)
+
+	err = Register("", CheckerFunc(func(ctx context.Context) error { return nil }), Readiness)
+	assert.NotNil(t, err)
+
+	err = Register("nil", nil, Readiness)
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []string{"test"}, Names(Readiness))
+	assert.Empty(t, Names(Liveness))
+}
+
+func TestReadiness(t *testing.T) {
+	defer Unregister("ok")
+	defer Unregister("failing")
+	defer SetReady(false)
+
+	_ = Register("ok", CheckerFunc(func(ctx context.Context) error { return nil }), Readiness)
+
+	SetReady(false)
+	assert.False(t, IsReady(context.Background()))
+
+	SetReady(true)
+	assert.True(t, IsReady(context.Background()))
+
+	_ = Register("failing", CheckerFunc(func(ctx context.Context) error { return errors.New("not connected") }), Readiness)
+
+	report := Ready(context.Background())
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, StatusUp, report.Checks["ok"].Status)
+	assert.Equal(t, StatusDown, report.Checks["failing"].Status)
+	assert.Equal(t, "not connected", report.Checks["failing"].Error)
+
+	// readiness checks don't affect liveness
+	assert.True(t, IsLive(context.Background()))
+}
+
+func TestCheckPanic(t *testing.T) {
+	defer Unregister("panic")
+
+	_ = Register("panic", CheckerFunc(func(ctx context.Context) error { panic("boom") }), Liveness)
+
+	report := Live(context.Background())
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, "health check panic: boom", report.Checks["panic"].Error)
+}
+
+func TestHandlers(t *testing.T) {
+	defer SetReady(false)
+
+	SetReady(false)
+	rec := httptest.NewRecorder()
+	ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	SetReady(true)
+	rec = httptest.NewRecorder()
+	ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
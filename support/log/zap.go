@@ -248,7 +248,7 @@ func newZapLogger(logFormat Format, level Level) (*zap.Logger, *zap.AtomicLevel,
 	cfg.EncoderConfig = eCfg
 
 	lvl := cfg.Level
-	zl, err := cfg.Build(zap.AddCallerSkip(1))
+	zl, err := cfg.Build(zap.AddCallerSkip(1), redactOption(&cfg))
 
 	return zl, &lvl, err
 }
@@ -271,7 +271,7 @@ func newZapTraceLogger(logFormat Format) (*zap.Logger, *zap.AtomicLevel, error)
 
 	lvl := cfg.Level
 	lvl.SetLevel(zapcore.DebugLevel)
-	zl, err := cfg.Build(zap.AddCallerSkip(1))
+	zl, err := cfg.Build(zap.AddCallerSkip(1), redactOption(&cfg))
 
 	return zl, &lvl, err
 }
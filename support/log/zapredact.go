@@ -0,0 +1,74 @@
+package log
+
+import (
+	"time"
+
+	"github.com/project-flogo/core/support/redact"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redactCore masks tracked secrets in log messages and fields
+type redactCore struct {
+	zapcore.Core
+}
+
+func newRedactCore(core zapcore.Core) zapcore.Core {
+	return &redactCore{Core: core}
+}
+
+// redactOption returns the option masking secrets in the logs of the config, the redacting core has to be
+// wrapped by the sampler, which decides in its Check whether an entry is logged, so the sampling of the config
+// is applied on top of it instead of by zap
+func redactOption(cfg *zap.Config) zap.Option {
+	sampling := cfg.Sampling
+	cfg.Sampling = nil
+
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		core = newRedactCore(core)
+		if sampling == nil {
+			return core
+		}
+		var opts []zapcore.SamplerOption
+		if sampling.Hook != nil {
+			opts = append(opts, zapcore.SamplerHook(sampling.Hook))
+		}
+		return zapcore.NewSamplerWithOptions(core, time.Second, sampling.Initial, sampling.Thereafter, opts...)
+	})
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = redact.String(entry.Message)
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	if !redact.Enabled() || len(fields) == 0 {
+		return fields
+	}
+
+	masked := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		if !redact.IsAllowed(f.Key) {
+			switch f.Type {
+			case zapcore.StringType:
+				f.String = redact.String(f.String)
+			case zapcore.ReflectType:
+				f.Interface = redact.Value(f.Interface)
+			}
+		}
+		masked[i] = f
+	}
+	return masked
+}
@@ -0,0 +1,39 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-flogo/core/support/redact"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRedactOptionSampling(t *testing.T) {
+	redact.Track("s3cr3t-token")
+	defer redact.Reset()
+
+	output := filepath.Join(t.TempDir(), "log.json")
+	cfg := zap.NewProductionConfig()
+	cfg.OutputPaths = []string{output}
+
+	zl, err := cfg.Build(redactOption(&cfg))
+	assert.Nil(t, err)
+
+	for i := 0; i < 300; i++ {
+		zl.Info("using token s3cr3t-token", zap.String("auth", "Bearer s3cr3t-token"))
+	}
+	_ = zl.Sync()
+
+	data, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	// the first 100 identical entries of a second are logged, then every 100th
+	assert.Len(t, lines, 102)
+	assert.NotContains(t, string(data), "s3cr3t-token")
+	assert.Contains(t, lines[0], "using token "+redact.Mask)
+	assert.Contains(t, lines[0], "Bearer "+redact.Mask)
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/support/log"
+)
+
+// Exporter pushes metrics to an external system
+type Exporter interface {
+	// Name of the exporter
+	Name() string
+
+	// Export exports a snapshot of the metrics
+	Export(families []*Family) error
+}
+
+var (
+	exporters     = make(map[string]Exporter)
+	exportersLock = &sync.RWMutex{}
+)
+
+// RegisterExporter registers a metrics exporter
+func RegisterExporter(exporter Exporter) error {
+
+	if exporter == nil {
+		return fmt.Errorf("cannot register 'nil' metrics exporter")
+	}
+
+	name := exporter.Name()
+	if name == "" {
+		return fmt.Errorf("a metrics exporter must have a non-empty name")
+	}
+
+	exportersLock.Lock()
+	defer exportersLock.Unlock()
+
+	if _, dup := exporters[name]; dup {
+		return fmt.Errorf("metrics exporter already registered: %s", name)
+	}
+
+	log.RootLogger().Debugf("Registering metrics exporter [ %s ]", name)
+
+	exporters[name] = exporter
+
+	return nil
+}
+
+// Exporters returns the registered metrics exporters
+func Exporters() []Exporter {
+	exportersLock.RLock()
+	defer exportersLock.RUnlock()
+
+	ret := make([]Exporter, 0, len(exporters))
+	for _, exporter := range exporters {
+		ret = append(ret, exporter)
+	}
+	return ret
+}
+
+// Publisher periodically exports the metrics of a registry using the registered exporters
+type Publisher struct {
+	registry *Registry
+	interval time.Duration
+	shutdown chan struct{}
+	done     sync.WaitGroup
+}
+
+// NewPublisher creates a new Publisher
+func NewPublisher(registry *Registry, interval time.Duration) *Publisher {
+	return &Publisher{registry: registry, interval: interval}
+}
+
+// Start implements managed.Managed.Start()
+func (p *Publisher) Start() error {
+	if p.shutdown != nil {
+		return nil
+	}
+
+	p.shutdown = make(chan struct{})
+	p.done.Add(1)
+
+	go func() {
+		defer p.done.Done()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.Publish()
+			case <-p.shutdown:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop implements managed.Managed.Stop(), a final export is done before stopping
+func (p *Publisher) Stop() error {
+	if p.shutdown != nil {
+		close(p.shutdown)
+		p.done.Wait()
+		p.shutdown = nil
+		p.Publish()
+	}
+	return nil
+}
+
+// Publish exports the current metrics using all the registered exporters
+func (p *Publisher) Publish() {
+	families := p.registry.Gather()
+	for _, exporter := range Exporters() {
+		err := exporter.Export(families)
+		if err != nil {
+			log.RootLogger().Warnf("Metrics exporter [ %s ] failed to export metrics: %s", exporter.Name(), err.Error())
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Type is the type of metric
+type Type string
+
+const (
+	TypeCounter   Type = "counter"
+	TypeGauge     Type = "gauge"
+	TypeHistogram Type = "histogram"
+)
+
+// DefaultBuckets are the default histogram buckets, tailored to measure latencies in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Counter is a metric that can only increase
+type Counter interface {
+	Inc()
+	Add(delta float64)
+}
+
+// Gauge is a metric that can go up and down
+type Gauge interface {
+	Set(value float64)
+	Inc()
+	Dec()
+	Add(delta float64)
+}
+
+// Histogram samples observations and counts them in buckets
+type Histogram interface {
+	Observe(value float64)
+}
+
+// Sample is a single value of a metric family
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Family is a group of samples of the same metric
+type Family struct {
+	Name    string
+	Help    string
+	Type    Type
+	Samples []*Sample
+}
+
+type collector interface {
+	metricType() Type
+	collect(family *Family)
+}
+
+// Registry holds a set of metrics
+type Registry struct {
+	mutex   sync.RWMutex
+	metrics map[string]collector
+	help    map[string]string
+}
+
+// NewRegistry creates a new Registry
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]collector), help: make(map[string]string)}
+}
+
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the default registry, used by the engine instrumentation
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewCounter creates a counter on the default registry
+func NewCounter(name, help string, labelNames ...string) *CounterVec {
+	return defaultRegistry.NewCounter(name, help, labelNames...)
+}
+
+// NewGauge creates a gauge on the default registry
+func NewGauge(name, help string, labelNames ...string) *GaugeVec {
+	return defaultRegistry.NewGauge(name, help, labelNames...)
+}
+
+// NewGaugeFunc creates a gauge on the default registry whose value is computed when gathered
+func NewGaugeFunc(name, help string, f func() float64) {
+	defaultRegistry.NewGaugeFunc(name, help, f)
+}
+
+// NewHistogram creates a histogram on the default registry
+func NewHistogram(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	return defaultRegistry.NewHistogram(name, help, buckets, labelNames...)
+}
+
+// NewCounter creates a counter, if a counter with the same name already exists it is returned
+func (r *Registry) NewCounter(name, help string, labelNames ...string) *CounterVec {
+	c := r.getOrRegister(name, help, func() collector {
+		return &CounterVec{vec: newVec(labelNames, func() interface{} { return &counter{} })}
+	})
+	if cv, ok := c.(*CounterVec); ok {
+		return cv
+	}
+	panic("metric '" + name + "' already registered with a different type")
+}
+
+// NewGauge creates a gauge, if a gauge with the same name already exists it is returned
+func (r *Registry) NewGauge(name, help string, labelNames ...string) *GaugeVec {
+	c := r.getOrRegister(name, help, func() collector {
+		return &GaugeVec{vec: newVec(labelNames, func() interface{} { return &gauge{} })}
+	})
+	if gv, ok := c.(*GaugeVec); ok {
+		return gv
+	}
+	panic("metric '" + name + "' already registered with a different type")
+}
+
+// NewGaugeFunc creates a gauge whose value is computed when gathered, replaces any existing gauge func with the same name
+func (r *Registry) NewGaugeFunc(name, help string, f func() float64) {
+	r.mutex.Lock()
+	r.metrics[name] = gaugeFunc(f)
+	r.help[name] = help
+	r.mutex.Unlock()
+}
+
+// NewHistogram creates a histogram, if a histogram with the same name already exists it is returned
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := make([]float64, len(buckets))
+	copy(sorted, buckets)
+	sort.Float64s(sorted)
+
+	c := r.getOrRegister(name, help, func() collector {
+		return &HistogramVec{buckets: sorted, vec: newVec(labelNames, func() interface{} { return newHistogram(sorted) })}
+	})
+	if hv, ok := c.(*HistogramVec); ok {
+		return hv
+	}
+	panic("metric '" + name + "' already registered with a different type")
+}
+
+// Unregister removes the named metric
+func (r *Registry) Unregister(name string) {
+	r.mutex.Lock()
+	delete(r.metrics, name)
+	delete(r.help, name)
+	r.mutex.Unlock()
+}
+
+// Gather returns a snapshot of all the metrics, sorted by name
+func (r *Registry) Gather() []*Family {
+	r.mutex.RLock()
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	families := make([]*Family, 0, len(names))
+	for _, name := range names {
+		c := r.metrics[name]
+		family := &Family{Name: name, Help: r.help[name], Type: c.metricType()}
+		c.collect(family)
+		families = append(families, family)
+	}
+	r.mutex.RUnlock()
+
+	return families
+}
+
+func (r *Registry) getOrRegister(name, help string, create func() collector) collector {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if c, exists := r.metrics[name]; exists {
+		return c
+	}
+
+	c := create()
+	r.metrics[name] = c
+	r.help[name] = help
+	return c
+}
+
+// vec holds the children of a metric, one for each combination of label values
+type vec struct {
+	mutex      sync.RWMutex
+	labelNames []string
+	children   map[string]*child
+	newMetric  func() interface{}
+}
+
+type child struct {
+	labels map[string]string
+	metric interface{}
+}
+
+func newVec(labelNames []string, newMetric func() interface{}) *vec {
+	return &vec{labelNames: labelNames, children: make(map[string]*child), newMetric: newMetric}
+}
+
+func (v *vec) with(labelValues []string) interface{} {
+	if len(labelValues) != len(v.labelNames) {
+		panic("inconsistent label cardinality")
+	}
+
+	key := strings.Join(labelValues, "\xff")
+
+	v.mutex.RLock()
+	c, exists := v.children[key]
+	v.mutex.RUnlock()
+	if exists {
+		return c.metric
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if c, exists = v.children[key]; exists {
+		return c.metric
+	}
+
+	labels := make(map[string]string, len(v.labelNames))
+	for i, name := range v.labelNames {
+		labels[name] = labelValues[i]
+	}
+	c = &child{labels: labels, metric: v.newMetric()}
+	v.children[key] = c
+
+	return c.metric
+}
+
+func (v *vec) sortedChildren() []*child {
+	v.mutex.RLock()
+	keys := make([]string, 0, len(v.children))
+	for key := range v.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	children := make([]*child, 0, len(keys))
+	for _, key := range keys {
+		children = append(children, v.children[key])
+	}
+	v.mutex.RUnlock()
+
+	return children
+}
+
+// CounterVec is a counter partitioned by labels
+type CounterVec struct {
+	*vec
+}
+
+// With returns the counter for the given label values
+func (cv *CounterVec) With(labelValues ...string) Counter {
+	return cv.with(labelValues).(*counter)
+}
+
+func (cv *CounterVec) metricType() Type {
+	return TypeCounter
+}
+
+func (cv *CounterVec) collect(family *Family) {
+	for _, c := range cv.sortedChildren() {
+		family.Samples = append(family.Samples, &Sample{Name: family.Name, Labels: c.labels, Value: c.metric.(*counter).value.load()})
+	}
+}
+
+// GaugeVec is a gauge partitioned by labels
+type GaugeVec struct {
+	*vec
+}
+
+// With returns the gauge for the given label values
+func (gv *GaugeVec) With(labelValues ...string) Gauge {
+	return gv.with(labelValues).(*gauge)
+}
+
+func (gv *GaugeVec) metricType() Type {
+	return TypeGauge
+}
+
+func (gv *GaugeVec) collect(family *Family) {
+	for _, c := range gv.sortedChildren() {
+		family.Samples = append(family.Samples, &Sample{Name: family.Name, Labels: c.labels, Value: c.metric.(*gauge).value.load()})
+	}
+}
+
+// HistogramVec is a histogram partitioned by labels
+type HistogramVec struct {
+	*vec
+	buckets []float64
+}
+
+// With returns the histogram for the given label values
+func (hv *HistogramVec) With(labelValues ...string) Histogram {
+	return hv.with(labelValues).(*histogram)
+}
+
+func (hv *HistogramVec) metricType() Type {
+	return TypeHistogram
+}
+
+func (hv *HistogramVec) collect(family *Family) {
+	for _, c := range hv.sortedChildren() {
+		h := c.metric.(*histogram)
+
+		var cumulative uint64
+		for i, upperBound := range h.upperBounds {
+			cumulative += atomic.LoadUint64(&h.counts[i])
+			family.Samples = append(family.Samples, &Sample{Name: family.Name + "_bucket", Labels: withLabel(c.labels, "le", formatFloat(upperBound)), Value: float64(cumulative)})
+		}
+		count := atomic.LoadUint64(&h.count)
+		family.Samples = append(family.Samples, &Sample{Name: family.Name + "_bucket", Labels: withLabel(c.labels, "le", "+Inf"), Value: float64(count)})
+		family.Samples = append(family.Samples, &Sample{Name: family.Name + "_sum", Labels: c.labels, Value: h.sum.load()})
+		family.Samples = append(family.Samples, &Sample{Name: family.Name + "_count", Labels: c.labels, Value: float64(count)})
+	}
+}
+
+type gaugeFunc func() float64
+
+func (gf gaugeFunc) metricType() Type {
+	return TypeGauge
+}
+
+func (gf gaugeFunc) collect(family *Family) {
+	family.Samples = append(family.Samples, &Sample{Name: family.Name, Value: gf()})
+}
+
+type atomicFloat struct {
+	bits uint64
+}
+
+func (f *atomicFloat) add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&f.bits)
+		updated := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&f.bits, old, updated) {
+			return
+		}
+	}
+}
+
+func (f *atomicFloat) set(value float64) {
+	atomic.StoreUint64(&f.bits, math.Float64bits(value))
+}
+
+func (f *atomicFloat) load() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&f.bits))
+}
+
+type counter struct {
+	value atomicFloat
+}
+
+func (c *counter) Inc() {
+	c.value.add(1)
+}
+
+func (c *counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+	c.value.add(delta)
+}
+
+type gauge struct {
+	value atomicFloat
+}
+
+func (g *gauge) Set(value float64) {
+	g.value.set(value)
+}
+
+func (g *gauge) Inc() {
+	g.value.add(1)
+}
+
+func (g *gauge) Dec() {
+	g.value.add(-1)
+}
+
+func (g *gauge) Add(delta float64) {
+	g.value.add(delta)
+}
+
+type histogram struct {
+	upperBounds []float64
+	counts      []uint64
+	count       uint64
+	sum         atomicFloat
+}
+
+func newHistogram(upperBounds []float64) *histogram {
+	return &histogram{upperBounds: upperBounds, counts: make([]uint64, len(upperBounds))}
+}
+
+func (h *histogram) Observe(value float64) {
+	idx := sort.SearchFloat64s(h.upperBounds, value)
+	if idx < len(h.upperBounds) {
+		atomic.AddUint64(&h.counts[idx], 1)
+	}
+	atomic.AddUint64(&h.count, 1)
+	h.sum.add(value)
+}
+
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	newLabels := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		newLabels[k] = v
+	}
+	newLabels[name] = value
+	return newLabels
+}
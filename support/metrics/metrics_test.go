@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_total", "A test counter", "status")
+	c.With("ok").Inc()
+	c.With("ok").Add(2)
+	c.With("failed").Inc()
+	c.With("failed").Add(-1)
+
+	// same metric is returned when created again
+	assert.Equal(t, c, r.NewCounter("test_total", "A test counter", "status"))
+
+	families := r.Gather()
+	assert.Len(t, families, 1)
+	assert.Equal(t, TypeCounter, families[0].Type)
+	assert.Len(t, families[0].Samples, 2)
+	assert.Equal(t, "failed", families[0].Samples[0].Labels["status"])
+	assert.Equal(t, float64(1), families[0].Samples[0].Value)
+	assert.Equal(t, float64(3), families[0].Samples[1].Value)
+}
+
+func TestGauge(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGauge("test_gauge", "A test gauge")
+	g.With().Inc()
+	g.With().Inc()
+	g.With().Dec()
+
+	r.NewGaugeFunc("test_func", "A test gauge func", func() float64 { return 42 })
+
+	families := r.Gather()
+	assert.Len(t, families, 2)
+	assert.Equal(t, "test_func", families[0].Name)
+	assert.Equal(t, float64(42), families[0].Samples[0].Value)
+	assert.Equal(t, float64(1), families[1].Samples[0].Value)
+}
+
+func TestDifferentType(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test", "")
+	assert.Panics(t, func() {
+		r.NewGauge("test", "")
+	})
+}
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogram("test_seconds", "A test histogram", []float64{1, 0.1}, "handler")
+	h.With("h1").Observe(0.05)
+	h.With("h1").Observe(0.5)
+	h.With("h1").Observe(5)
+
+	r.NewCounter("test_total", "A \"test\" counter", "name").With("a\"b").Inc()
+
+	buf := &bytes.Buffer{}
+	err := WriteText(buf, r.Gather())
+	assert.Nil(t, err)
+
+	expected := `# HELP test_seconds A test histogram
+# TYPE test_seconds histogram
+test_seconds_bucket{handler="h1",le="0.1"} 1
+test_seconds_bucket{handler="h1",le="1"} 2
+test_seconds_bucket{handler="h1",le="+Inf"} 3
+test_seconds_sum{handler="h1"} 5.55
+test_seconds_count{handler="h1"} 3
+# HELP test_total A "test" counter
+# TYPE test_total counter
+test_total{name="a\"b"} 1
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+type testExporter struct {
+	families []*Family
+}
+
+func (e *testExporter) Name() string {
+	return "test"
+}
+
+func (e *testExporter) Export(families []*Family) error {
+	e.families = families
+	return nil
+}
+
+func TestPublisher(t *testing.T) {
+	exporter := &testExporter{}
+	err := RegisterExporter(exporter)
+	assert.Nil(t, err)
+	err = RegisterExporter(exporter)
+	assert.NotNil(t, err)
+
+	r := NewRegistry()
+	r.NewCounter("test_total", "").With().Inc()
+
+	p := NewPublisher(r, time.Hour)
+	_ = p.Start()
+	_ = p.Stop()
+
+	assert.Len(t, exporter.families, 1)
+}
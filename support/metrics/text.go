@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ContentTypeText is the content type of the text exposition format
+const ContentTypeText = "text/plain; version=0.0.4; charset=utf-8"
+
+// WriteText writes the metric families using the Prometheus text exposition format
+func WriteText(w io.Writer, families []*Family) error {
+	bw := bufio.NewWriter(w)
+
+	for _, family := range families {
+		if family.Help != "" {
+			bw.WriteString("# HELP " + family.Name + " " + escapeHelp(family.Help) + "\n")
+		}
+		bw.WriteString("# TYPE " + family.Name + " " + string(family.Type) + "\n")
+
+		for _, sample := range family.Samples {
+			bw.WriteString(sample.Name)
+			if len(sample.Labels) > 0 {
+				bw.WriteString("{")
+				bw.WriteString(formatLabels(sample.Labels))
+				bw.WriteString("}")
+			}
+			bw.WriteString(" " + formatFloat(sample.Value) + "\n")
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Handler returns a http.Handler that exposes the metrics of the default registry
+func Handler() http.Handler {
+	return HandlerFor(defaultRegistry)
+}
+
+// HandlerFor returns a http.Handler that exposes the metrics of the specified registry
+func HandlerFor(registry *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeText)
+		_ = WriteText(w, registry.Gather())
+	})
+}
+
+func formatLabels(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+`="`+escapeLabelValue(labels[name])+`"`)
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeLabelValue(s string) string {
+	return labelValueEscaper.Replace(s)
+}
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
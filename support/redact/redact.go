@@ -0,0 +1,190 @@
+package redact
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// EnvKeyRedactAllow is a comma separated list of the fields whose values are never masked
+	EnvKeyRedactAllow = "FLOGO_REDACT_ALLOW"
+
+	// Mask replaces a secret value
+	Mask = "********"
+
+	// minEmbeddedLength is the minimum length of a secret that is masked when it is part of a larger string,
+	// shorter secrets are only masked when they are the whole value
+	minEmbeddedLength = 4
+)
+
+// Redactor can be implemented by a value that contains secrets in fields that Value cannot reach (ex. a struct),
+// it should return a copy of the value with the secrets masked
+type Redactor interface {
+	Redact() interface{}
+}
+
+var (
+	mutex   sync.RWMutex
+	secrets = make(map[string]struct{})
+	// sorted longest first, so a secret that contains another is masked as a whole
+	sorted  []string
+	allowed = make(map[string]bool)
+	tracked int32
+)
+
+func init() {
+	if allow := os.Getenv(EnvKeyRedactAllow); allow != "" {
+		for _, field := range strings.Split(allow, ",") {
+			Allow(strings.TrimSpace(field))
+		}
+	}
+}
+
+// Track registers a value derived from a secret, so that it is masked
+func Track(value string) {
+	if value == "" {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, exists := secrets[value]; exists {
+		return
+	}
+	secrets[value] = struct{}{}
+	sorted = append(sorted, value)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+	atomic.StoreInt32(&tracked, 1)
+}
+
+// IsTracked returns true if the value is a tracked secret
+func IsTracked(value string) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	_, exists := secrets[value]
+	return exists
+}
+
+// Enabled returns true if any secret is being tracked
+func Enabled() bool {
+	return atomic.LoadInt32(&tracked) == 1
+}
+
+// Allow adds fields to the allow-list, the value of an allowed field is never masked
+func Allow(fields ...string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for _, field := range fields {
+		if field != "" {
+			allowed[field] = true
+		}
+	}
+}
+
+// IsAllowed returns true if the field is in the allow-list
+func IsAllowed(field string) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return allowed[field]
+}
+
+// Reset clears the tracked secrets and the allow-list
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	secrets = make(map[string]struct{})
+	sorted = nil
+	allowed = make(map[string]bool)
+	atomic.StoreInt32(&tracked, 0)
+}
+
+// String masks the secrets in a string
+func String(s string) string {
+	if !Enabled() || s == "" {
+		return s
+	}
+
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	if _, exists := secrets[s]; exists {
+		return Mask
+	}
+	for _, secret := range sorted {
+		if len(secret) >= minEmbeddedLength && strings.Contains(s, secret) {
+			s = strings.ReplaceAll(s, secret, Mask)
+		}
+	}
+	return s
+}
+
+// Field masks the secrets in the value of a field, unless the field is in the allow-list
+func Field(name string, val interface{}) interface{} {
+	if !Enabled() || IsAllowed(name) {
+		return val
+	}
+	return Value(val)
+}
+
+// Value masks the secrets in a value.  Strings, maps and arrays are masked and copied, the values of allowed
+// fields are left as is.  Other values are returned as is, unless they implement Redactor.
+func Value(val interface{}) interface{} {
+	if !Enabled() {
+		return val
+	}
+
+	switch t := val.(type) {
+	case string:
+		return String(t)
+	case map[string]interface{}:
+		return Map(t)
+	case map[string]string:
+		masked := make(map[string]string, len(t))
+		for k, v := range t {
+			if IsAllowed(k) {
+				masked[k] = v
+			} else {
+				masked[k] = String(v)
+			}
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, len(t))
+		for i, v := range t {
+			masked[i] = Value(v)
+		}
+		return masked
+	case []string:
+		masked := make([]string, len(t))
+		for i, v := range t {
+			masked[i] = String(v)
+		}
+		return masked
+	case Redactor:
+		return t.Redact()
+	}
+	return val
+}
+
+// Map returns a copy of the map with the secrets masked
+func Map(m map[string]interface{}) map[string]interface{} {
+	if !Enabled() || m == nil {
+		return m
+	}
+
+	masked := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		masked[k] = Field(k, v)
+	}
+	return masked
+}
@@ -0,0 +1,259 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/project-flogo/core/activity"
+)
+
+// Backoff is the strategy used to compute the delay between attempts
+type Backoff string
+
+const (
+	// Fixed waits the same delay between each attempt
+	Fixed Backoff = "fixed"
+	// Exponential multiplies the delay after each attempt
+	Exponential Backoff = "exponential"
+
+	DefaultMaxAttempts = 3
+	DefaultDelay       = 100 * time.Millisecond
+	DefaultMaxDelay    = 30 * time.Second
+	DefaultMultiplier  = 2.0
+)
+
+// Config is the declarative configuration of a retry policy
+type Config struct {
+	// MaxAttempts is the maximum number of attempts, including the first one, defaults to 3
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// Backoff is the backoff strategy, fixed or exponential (default)
+	Backoff Backoff `json:"backoff,omitempty"`
+	// Delay is the delay before the first retry, defaults to 100ms
+	Delay string `json:"delay,omitempty"`
+	// MaxDelay is the maximum delay between attempts, defaults to 30s
+	MaxDelay string `json:"maxDelay,omitempty"`
+	// Multiplier is the factor the delay is multiplied by after each attempt when using exponential backoff, defaults to 2
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// Jitter is the fraction of the delay that is randomized, between 0 and 1
+	Jitter float64 `json:"jitter,omitempty"`
+	// RetryUnknownErrors indicates if errors that are not activity errors are retried
+	RetryUnknownErrors bool `json:"retryUnknownErrors,omitempty"`
+	// Categories are the rules for specific activity error categories e.g. TIMEOUT-ERROR, they override the
+	// policy settings and the error's retriable flag
+	Categories map[string]*Rule `json:"categories,omitempty"`
+}
+
+// Rule is the retry rule for a specific error category
+type Rule struct {
+	// Retry indicates if errors of the category are retried, if not set the error's retriable flag is used
+	Retry *bool `json:"retry,omitempty"`
+	// MaxAttempts overrides the policy's maximum number of attempts
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// Backoff overrides the policy's backoff strategy
+	Backoff Backoff `json:"backoff,omitempty"`
+	// Delay overrides the policy's delay
+	Delay string `json:"delay,omitempty"`
+}
+
+type schedule struct {
+	maxAttempts int
+	backoff     Backoff
+	delay       time.Duration
+}
+
+type rule struct {
+	retry *bool
+	schedule
+}
+
+// Policy decides if and when a failed operation is retried
+type Policy struct {
+	schedule
+	maxDelay     time.Duration
+	multiplier   float64
+	jitter       float64
+	retryUnknown bool
+	categories   map[string]*rule
+}
+
+// NewPolicy creates a retry Policy from its declarative configuration
+func NewPolicy(config *Config) (*Policy, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	p := &Policy{schedule: schedule{maxAttempts: DefaultMaxAttempts, backoff: Exponential, delay: DefaultDelay},
+		maxDelay: DefaultMaxDelay, multiplier: DefaultMultiplier, retryUnknown: config.RetryUnknownErrors}
+
+	var err error
+	p.schedule, err = toSchedule(p.schedule, config.MaxAttempts, config.Backoff, config.Delay)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.MaxDelay != "" {
+		p.maxDelay, err = parseDuration("maxDelay", config.MaxDelay)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Multiplier != 0 {
+		if config.Multiplier < 1 {
+			return nil, fmt.Errorf("invalid retry multiplier: %v", config.Multiplier)
+		}
+		p.multiplier = config.Multiplier
+	}
+
+	if config.Jitter < 0 || config.Jitter > 1 {
+		return nil, fmt.Errorf("invalid retry jitter: %v", config.Jitter)
+	}
+	p.jitter = config.Jitter
+
+	if len(config.Categories) > 0 {
+		p.categories = make(map[string]*rule, len(config.Categories))
+		for category, r := range config.Categories {
+			if r == nil {
+				continue
+			}
+			s, err := toSchedule(p.schedule, r.MaxAttempts, r.Backoff, r.Delay)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry rule for category '%s': %s", category, err.Error())
+			}
+			p.categories[strings.ToUpper(category)] = &rule{retry: r.Retry, schedule: s}
+		}
+	}
+
+	return p, nil
+}
+
+func toSchedule(defaults schedule, maxAttempts int, backoff Backoff, delay string) (schedule, error) {
+	s := defaults
+
+	if maxAttempts < 0 {
+		return s, fmt.Errorf("invalid retry max attempts: %d", maxAttempts)
+	} else if maxAttempts > 0 {
+		s.maxAttempts = maxAttempts
+	}
+
+	switch {
+	case backoff == "":
+	case strings.EqualFold(string(backoff), string(Fixed)):
+		s.backoff = Fixed
+	case strings.EqualFold(string(backoff), string(Exponential)):
+		s.backoff = Exponential
+	default:
+		return s, fmt.Errorf("unsupported retry backoff: %s", backoff)
+	}
+
+	if delay != "" {
+		var err error
+		s.delay, err = parseDuration("delay", delay)
+		if err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+func parseDuration(name, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid retry %s: %s", name, value)
+	}
+	return d, nil
+}
+
+// NextDelay returns the delay before the next attempt after the specified attempt failed with err, false
+// is returned if the operation should not be retried
+func (p *Policy) NextDelay(attempt int, err error) (time.Duration, bool) {
+	if p == nil || err == nil {
+		return 0, false
+	}
+
+	s := p.schedule
+	var retriable bool
+
+	var actErr *activity.Error
+	if errors.As(err, &actErr) {
+		retriable = actErr.Retriable()
+		if r, exists := p.categories[strings.ToUpper(actErr.Category())]; exists {
+			s = r.schedule
+			if r.retry != nil {
+				retriable = *r.retry
+			}
+		}
+	} else {
+		retriable = p.retryUnknown
+	}
+
+	if !retriable || attempt >= s.maxAttempts {
+		return 0, false
+	}
+
+	delay := float64(s.delay)
+	if s.backoff == Exponential {
+		delay *= math.Pow(p.multiplier, float64(attempt-1))
+	}
+	if delay > float64(p.maxDelay) {
+		delay = float64(p.maxDelay)
+	}
+	if p.jitter > 0 {
+		// spread the delay uniformly over [delay*(1-jitter), delay*(1+jitter)], never exceeding maxDelay
+		delay += delay * p.jitter * (2*rand.Float64() - 1)
+		if delay > float64(p.maxDelay) {
+			delay = float64(p.maxDelay)
+		}
+	}
+
+	return time.Duration(delay), true
+}
+
+// Execute invokes fn until it succeeds, the error is not retriable or the attempts are exhausted.  The
+// number of attempts made and the last error are returned.  onRetry, if not nil, is invoked before each retry.
+func (p *Policy) Execute(ctx context.Context, fn func(ctx context.Context) error, onRetry func(attempt int, delay time.Duration, err error)) (int, error) {
+	attempt := 1
+	for {
+		err := fn(NewContext(ctx, attempt))
+		delay, retry := p.NextDelay(attempt, err)
+		if !retry {
+			return attempt, err
+		}
+
+		if onRetry != nil {
+			onRetry(attempt+1, delay, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, err
+		}
+		attempt++
+	}
+}
+
+type attemptKeyType int
+
+var attemptKey attemptKeyType
+
+// NewContext returns a child context that carries the attempt number
+func NewContext(parent context.Context, attempt int) context.Context {
+	return context.WithValue(parent, attemptKey, attempt)
+}
+
+// AttemptFromContext returns the attempt number carried by the context, 1 if there is none
+func AttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey).(int); ok {
+		return attempt
+	}
+	return 1
+}
@@ -0,0 +1,117 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/project-flogo/core/activity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPolicy(t *testing.T) {
+	p, err := NewPolicy(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, p)
+
+	_, err = NewPolicy(&Config{Backoff: "linear"})
+	assert.NotNil(t, err)
+	_, err = NewPolicy(&Config{Delay: "abc"})
+	assert.NotNil(t, err)
+	_, err = NewPolicy(&Config{Jitter: 2})
+	assert.NotNil(t, err)
+	_, err = NewPolicy(&Config{Categories: map[string]*Rule{"TIMEOUT-ERROR": {Delay: "-1s"}}})
+	assert.NotNil(t, err)
+}
+
+func TestNextDelay(t *testing.T) {
+	yes, no := true, false
+	p, err := NewPolicy(&Config{MaxAttempts: 4, Delay: "100ms", MaxDelay: "300ms", Categories: map[string]*Rule{
+		string(activity.TimeoutError):    {Retry: &yes, Backoff: Fixed, Delay: "250ms", MaxAttempts: 2},
+		string(activity.ConnectionError): {Retry: &no},
+	}})
+	assert.Nil(t, err)
+
+	retriable := activity.NewRetriableError("failed", "E1", nil)
+
+	delay, retry := p.NextDelay(1, retriable)
+	assert.True(t, retry)
+	assert.Equal(t, 100*time.Millisecond, delay)
+	delay, retry = p.NextDelay(2, retriable)
+	assert.True(t, retry)
+	assert.Equal(t, 200*time.Millisecond, delay)
+	delay, retry = p.NextDelay(3, retriable)
+	assert.True(t, retry)
+	assert.Equal(t, 300*time.Millisecond, delay)
+	_, retry = p.NextDelay(4, retriable)
+	assert.False(t, retry)
+
+	_, retry = p.NextDelay(1, activity.NewError("failed", "E2", nil))
+	assert.False(t, retry)
+	_, retry = p.NextDelay(1, errors.New("failed"))
+	assert.False(t, retry)
+	_, retry = p.NextDelay(1, nil)
+	assert.False(t, retry)
+
+	// category rules override the policy and the retriable flag
+	timeout := activity.NewActivityError("timed out", "E3", activity.TimeoutError, nil)
+	delay, retry = p.NextDelay(1, timeout)
+	assert.True(t, retry)
+	assert.Equal(t, 250*time.Millisecond, delay)
+	_, retry = p.NextDelay(2, timeout)
+	assert.False(t, retry)
+
+	_, retry = p.NextDelay(1, activity.NewRetriableActivityError("refused", "E4", activity.ConnectionError, nil))
+	assert.False(t, retry)
+}
+
+func TestJitter(t *testing.T) {
+	p, err := NewPolicy(&Config{Backoff: Fixed, Delay: "100ms", Jitter: 0.5, RetryUnknownErrors: true})
+	assert.Nil(t, err)
+
+	for i := 0; i < 20; i++ {
+		delay, retry := p.NextDelay(1, errors.New("failed"))
+		assert.True(t, retry)
+		assert.True(t, delay >= 50*time.Millisecond && delay <= 150*time.Millisecond)
+	}
+
+	// the jittered delay never exceeds maxDelay
+	p, err = NewPolicy(&Config{Delay: "100ms", MaxDelay: "200ms", Jitter: 0.5, MaxAttempts: 10, RetryUnknownErrors: true})
+	assert.Nil(t, err)
+
+	for i := 0; i < 50; i++ {
+		delay, retry := p.NextDelay(5, errors.New("failed"))
+		assert.True(t, retry)
+		assert.True(t, delay >= 100*time.Millisecond && delay <= 200*time.Millisecond, delay.String())
+	}
+}
+
+func TestExecute(t *testing.T) {
+	p, err := NewPolicy(&Config{MaxAttempts: 3, Delay: "1ms"})
+	assert.Nil(t, err)
+
+	var seen []int
+	var retries []int
+	attempts, err := p.Execute(context.Background(), func(ctx context.Context) error {
+		attempt := AttemptFromContext(ctx)
+		seen = append(seen, attempt)
+		if attempt < 2 {
+			return activity.NewRetriableError("failed", "E1", nil)
+		}
+		return nil
+	}, func(attempt int, delay time.Duration, err error) {
+		retries = append(retries, attempt)
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []int{1, 2}, seen)
+	assert.Equal(t, []int{2}, retries)
+
+	attempts, err = p.Execute(context.Background(), func(ctx context.Context) error {
+		return activity.NewRetriableError("failed", "E1", nil)
+	}, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, attempts)
+}
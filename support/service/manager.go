@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/project-flogo/core/support/managed"
@@ -91,14 +92,17 @@ func (sm *Manager) FindService(f func (Service) bool) Service {
 }
 
 
-// Start implements util.Managed.Start()
+// Start implements util.Managed.Start(), services are started in dependency order
 func (sm *Manager) Start() error {
 
 	sm.Lock()
 	defer sm.Unlock()
 
 	if len(sm.started) == 0 {
-		services := sm.allServices()
+		services, err := sm.orderedServices()
+		if err != nil {
+			return err
+		}
 
 		sm.started = make([]Service, 0, len(services))
 
@@ -116,7 +120,7 @@ func (sm *Manager) Start() error {
 	return nil
 }
 
-// Stop implements util.Managed.Stop()
+// Stop implements util.Managed.Stop(), services are stopped in reverse dependency order
 func (sm *Manager) Stop() error {
 
 	sm.Lock()
@@ -128,12 +132,14 @@ func (sm *Manager) Stop() error {
 
 		var notStopped []Service
 
-		for _, service := range sm.started {
+		for i := len(sm.started) - 1; i >= 0; i-- {
+			service := sm.started[i]
 
-			err = managed.Stop(service.Name(), service)
+			stopErr := managed.Stop(service.Name(), service)
 
-			if err != nil {
-				notStopped = append(notStopped, service)
+			if stopErr != nil {
+				err = stopErr
+				notStopped = append([]Service{service}, notStopped...)
 			}
 		}
 
@@ -142,3 +148,58 @@ func (sm *Manager) Stop() error {
 
 	return err
 }
+
+// orderedServices returns the services sorted so that a service follows the services it depends on
+func (sm *Manager) orderedServices() ([]Service, error) {
+
+	names := make([]string, 0, len(sm.services))
+	for name := range sm.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(names))
+	ordered := make([]Service, 0, len(names))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular service dependency involving: %s", name)
+		}
+
+		state[name] = visiting
+
+		service := sm.services[name]
+		if da, ok := service.(DependencyAware); ok {
+			for _, dep := range da.Dependencies() {
+				if _, exists := sm.services[dep]; !exists {
+					return fmt.Errorf("service '%s' depends on unregistered service '%s'", name, dep)
+				}
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[name] = visited
+		ordered = append(ordered, service)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
@@ -117,6 +117,54 @@ func TestManager_Stop(t *testing.T) {
 	}
 }
 
+type TestDependentService struct {
+	TestService
+	deps  []string
+	order *[]string
+}
+
+func (t *TestDependentService) Start() error {
+	*t.order = append(*t.order, "start:"+t.name)
+	return nil
+}
+
+func (t *TestDependentService) Stop() error {
+	*t.order = append(*t.order, "stop:"+t.name)
+	return nil
+}
+
+func (t *TestDependentService) Dependencies() []string {
+	return t.deps
+}
+
+func TestManager_DependencyOrder(t *testing.T) {
+	var order []string
+
+	sm := NewServiceManager()
+	_ = sm.RegisterService(&TestDependentService{TestService: TestService{name: "a"}, deps: []string{"c"}, order: &order})
+	_ = sm.RegisterService(&TestDependentService{TestService: TestService{name: "b"}, order: &order})
+	_ = sm.RegisterService(&TestDependentService{TestService: TestService{name: "c"}, deps: []string{"b"}, order: &order})
+
+	err := sm.Start()
+	assert.Nil(t, err)
+	err = sm.Stop()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"start:b", "start:c", "start:a", "stop:a", "stop:c", "stop:b"}, order)
+}
+
+func TestManager_CircularDependency(t *testing.T) {
+	var order []string
+
+	sm := NewServiceManager()
+	_ = sm.RegisterService(&TestDependentService{TestService: TestService{name: "a"}, deps: []string{"b"}, order: &order})
+	_ = sm.RegisterService(&TestDependentService{TestService: TestService{name: "b"}, deps: []string{"a"}, order: &order})
+
+	err := sm.Start()
+	assert.NotNil(t, err)
+	assert.Empty(t, order)
+}
+
 func TestNewServiceManager(t *testing.T) {
 
 	sm := NewServiceManager()
@@ -10,6 +10,13 @@ type Service interface {
 	Name() string
 }
 
+// DependencyAware is implemented by a service that depends on other services, a service is
+// started after the services it depends on and stopped before them
+type DependencyAware interface {
+	// Dependencies returns the names of the services this service depends on
+	Dependencies() []string
+}
+
 // Config is a simple service configuration object
 type Config struct {
 	Settings map[string]interface{} `json:"settings,omitempty"`
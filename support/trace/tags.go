@@ -4,6 +4,7 @@ import (
 	"github.com/project-flogo/core/data"
 	"github.com/project-flogo/core/data/expression"
 	"github.com/project-flogo/core/data/mapper"
+	"github.com/project-flogo/core/support/redact"
 )
 
 type TagDef struct {
@@ -99,11 +100,12 @@ func ResolveTagDefs(td *TagDefs, scope data.Scope) map[string]interface{} {
 		return nil
 	}
 
+	// tag values derived from secrets are masked, unless the tag is in the redact allow-list
 	if td.dynamicExpr != nil {
-		return resolveDynamicTags(td.dynamicExpr, scope)
+		return redact.Map(resolveDynamicTags(td.dynamicExpr, scope))
 	}
 
-	return resolveStaticTags(td.staticDefs, scope)
+	return redact.Map(resolveStaticTags(td.staticDefs, scope))
 }
 
 func resolveStaticTags(defs []*TagDef, scope data.Scope) map[string]interface{} {
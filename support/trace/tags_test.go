@@ -6,6 +6,7 @@ import (
 
 	"github.com/project-flogo/core/data"
 	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/support/redact"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -302,6 +303,26 @@ func TestResolveTagDefs_StaticTags(t *testing.T) {
 	assert.Equal(t, "us-east", result["region"])
 }
 
+func TestResolveTagDefs_RedactSecrets(t *testing.T) {
+	redact.Track("mypassword")
+	redact.Allow("password.hint")
+	defer redact.Reset()
+
+	scope := data.NewSimpleScope(map[string]interface{}{
+		"password": "mypassword",
+	}, nil)
+
+	td := &TagDefs{staticDefs: []*TagDef{
+		{Name: "password", Value: "=password", expr: &testExpr{str: "password"}},
+		{Name: "password.hint", Value: "=password", expr: &testExpr{str: "password"}},
+		{Name: "env", Value: "prod"},
+	}}
+	result := ResolveTagDefs(td, scope)
+	assert.Equal(t, redact.Mask, result["password"])
+	assert.Equal(t, "mypassword", result["password.hint"])
+	assert.Equal(t, "prod", result["env"])
+}
+
 func TestResolveTagDefs_ValueExpression(t *testing.T) {
 	scope := data.NewSimpleScope(map[string]interface{}{
 		"myVar": "resolved-value",
@@ -0,0 +1,195 @@
+package trigger
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// WhenFull is the behaviour of a handler when its concurrency limit is reached and its queue is full
+type WhenFull string
+
+const (
+	// WhenFullBlock blocks the caller until a slot is free, the queue size is not enforced
+	WhenFullBlock WhenFull = "block"
+	// WhenFullReject rejects the new event
+	WhenFullReject WhenFull = "reject"
+	// WhenFullShedOldest rejects the oldest queued event to make room for the new event
+	WhenFullShedOldest WhenFull = "shedOldest"
+)
+
+// ConcurrencyConfig is the concurrency configuration of a handler
+type ConcurrencyConfig struct {
+	// MaxConcurrency is the maximum number of events handled concurrently, 0 means unlimited
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// QueueSize is the maximum number of events waiting for a free slot
+	QueueSize int `json:"queueSize,omitempty"`
+	// WhenFull is the behaviour when the queue is full, defaults to block
+	WhenFull WhenFull `json:"whenFull,omitempty"`
+}
+
+// RejectedError is returned by a handler when an event is rejected because the handler is at capacity
+type RejectedError struct {
+	Trigger string
+	Handler string
+	// Shed indicates the event was queued, but was shed to make room for a newer event
+	Shed bool
+}
+
+func (e *RejectedError) Error() string {
+	if e.Shed {
+		return fmt.Sprintf("event shed by handler [%s] of trigger [%s]: handler at capacity", e.Handler, e.Trigger)
+	}
+	return fmt.Sprintf("event rejected by handler [%s] of trigger [%s]: handler at capacity", e.Handler, e.Trigger)
+}
+
+// bulkhead limits the number of events concurrently handled by a handler
+type bulkhead struct {
+	mutex          sync.Mutex
+	triggerId      string
+	handlerName    string
+	maxConcurrency int
+	queueSize      int
+	whenFull       WhenFull
+	active         int
+	waiting        *list.List
+	metrics        *bulkheadMetrics
+}
+
+func newBulkhead(hc *HandlerConfig) (*bulkhead, error) {
+	config := hc.Concurrency
+	if config == nil || config.MaxConcurrency <= 0 {
+		return nil, nil
+	}
+
+	if config.QueueSize < 0 {
+		return nil, fmt.Errorf("invalid concurrency queue size for handler [%s]: %d", hc.Name, config.QueueSize)
+	}
+
+	var whenFull WhenFull
+	switch {
+	case config.WhenFull == "", strings.EqualFold(string(config.WhenFull), string(WhenFullBlock)):
+		whenFull = WhenFullBlock
+	case strings.EqualFold(string(config.WhenFull), string(WhenFullReject)):
+		whenFull = WhenFullReject
+	case strings.EqualFold(string(config.WhenFull), string(WhenFullShedOldest)):
+		whenFull = WhenFullShedOldest
+	default:
+		return nil, fmt.Errorf("unsupported concurrency whenFull behaviour for handler [%s]: %s", hc.Name, config.WhenFull)
+	}
+
+	b := &bulkhead{maxConcurrency: config.MaxConcurrency, queueSize: config.QueueSize, whenFull: whenFull, waiting: list.New()}
+	b.handlerName = hc.Name
+	if hc.Parent != nil {
+		b.triggerId = hc.Parent.Id
+	}
+	b.metrics = newBulkheadMetrics(b.triggerId, b.handlerName)
+
+	return b, nil
+}
+
+// enter obtains a slot to handle an event, a REJECTED handler event is posted if the event is rejected
+func (b *bulkhead) enter(ctx context.Context, eventData map[string]string) error {
+	if b == nil {
+		return nil
+	}
+
+	err := b.acquire(ctx)
+	if _, rejected := err.(*RejectedError); rejected {
+		PostHandlerEvent(REJECTED, b.handlerName, b.triggerId, eventData)
+	}
+	return err
+}
+
+// exit frees the slot obtained by enter
+func (b *bulkhead) exit() {
+	if b != nil {
+		b.release()
+	}
+}
+
+// acquire obtains a slot to handle an event, waiting in the queue if the handler is at capacity.  A
+// *RejectedError is returned if the event is rejected or shed.
+func (b *bulkhead) acquire(ctx context.Context) error {
+
+	b.mutex.Lock()
+
+	if b.active < b.maxConcurrency {
+		b.active++
+		b.mutex.Unlock()
+		return nil
+	}
+
+	if b.waiting.Len() >= b.queueSize {
+		switch b.whenFull {
+		case WhenFullReject:
+			b.mutex.Unlock()
+			b.metrics.rejected.Inc()
+			return &RejectedError{Trigger: b.triggerId, Handler: b.handlerName}
+		case WhenFullShedOldest:
+			oldest := b.waiting.Front()
+			if oldest == nil {
+				// nothing queued that can be shed
+				b.mutex.Unlock()
+				b.metrics.rejected.Inc()
+				return &RejectedError{Trigger: b.triggerId, Handler: b.handlerName}
+			}
+			b.waiting.Remove(oldest)
+			oldest.Value.(chan bool) <- false
+		}
+		// when blocking, the event simply waits along with the queued events
+	}
+
+	ready := make(chan bool, 1)
+	elem := b.waiting.PushBack(ready)
+	b.metrics.queued.Set(float64(b.waiting.Len()))
+	b.mutex.Unlock()
+
+	select {
+	case ok := <-ready:
+		b.metrics.queued.Set(float64(b.queued()))
+		if !ok {
+			b.metrics.shed.Inc()
+			return &RejectedError{Trigger: b.triggerId, Handler: b.handlerName, Shed: true}
+		}
+		return nil
+	case <-ctx.Done():
+		b.mutex.Lock()
+		select {
+		case ok := <-ready:
+			// a slot was handed over or the event was shed while it was being cancelled
+			b.mutex.Unlock()
+			if ok {
+				b.release()
+			}
+		default:
+			b.waiting.Remove(elem)
+			b.mutex.Unlock()
+		}
+		b.metrics.queued.Set(float64(b.queued()))
+		return ctx.Err()
+	}
+}
+
+// release frees a slot, handing it over to the oldest queued event if there is one
+func (b *bulkhead) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if next := b.waiting.Front(); next != nil {
+		b.waiting.Remove(next)
+		next.Value.(chan bool) <- true
+		return
+	}
+
+	b.active--
+}
+
+// queued returns the number of events waiting for a slot
+func (b *bulkhead) queued() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.waiting.Len()
+}
@@ -0,0 +1,106 @@
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBulkhead(t *testing.T, name string, maxConcurrency, queueSize int, whenFull WhenFull) *bulkhead {
+	hc := &HandlerConfig{Name: name, Parent: &Config{Id: "bulkheadTrig"}}
+	hc.Concurrency = &ConcurrencyConfig{MaxConcurrency: maxConcurrency, QueueSize: queueSize, WhenFull: whenFull}
+
+	b, err := newBulkhead(hc)
+	assert.Nil(t, err)
+	assert.NotNil(t, b)
+	return b
+}
+
+func waitForQueued(b *bulkhead, n int) {
+	for b.queued() != n {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNewBulkhead(t *testing.T) {
+	b, err := newBulkhead(&HandlerConfig{Name: "none"})
+	assert.Nil(t, err)
+	assert.Nil(t, b)
+
+	// a nil bulkhead never limits
+	assert.Nil(t, b.enter(context.Background(), nil))
+	b.exit()
+
+	_, err = newBulkhead(&HandlerConfig{Name: "invalid", Concurrency: &ConcurrencyConfig{MaxConcurrency: 1, WhenFull: "drop"}})
+	assert.NotNil(t, err)
+}
+
+func TestBulkheadReject(t *testing.T) {
+	b := newTestBulkhead(t, "reject", 1, 1, WhenFullReject)
+
+	assert.Nil(t, b.acquire(context.Background()))
+
+	queued := make(chan error)
+	go func() {
+		queued <- b.acquire(context.Background())
+	}()
+	waitForQueued(b, 1)
+
+	err := b.acquire(context.Background())
+	assert.IsType(t, &RejectedError{}, err)
+	assert.False(t, err.(*RejectedError).Shed)
+
+	b.release()
+	assert.Nil(t, <-queued)
+	b.release()
+}
+
+func TestBulkheadShedOldest(t *testing.T) {
+	b := newTestBulkhead(t, "shed", 1, 1, WhenFullShedOldest)
+
+	assert.Nil(t, b.acquire(context.Background()))
+
+	oldest := make(chan error)
+	go func() {
+		oldest <- b.acquire(context.Background())
+	}()
+	waitForQueued(b, 1)
+
+	newest := make(chan error)
+	go func() {
+		newest <- b.acquire(context.Background())
+	}()
+
+	err := <-oldest
+	assert.IsType(t, &RejectedError{}, err)
+	assert.True(t, err.(*RejectedError).Shed)
+
+	waitForQueued(b, 1)
+	b.release()
+	assert.Nil(t, <-newest)
+	b.release()
+}
+
+func TestBulkheadBlock(t *testing.T) {
+	b := newTestBulkhead(t, "block", 1, 0, WhenFullBlock)
+
+	assert.Nil(t, b.acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, b.acquire(ctx))
+	assert.Equal(t, 0, b.queued())
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- b.acquire(context.Background())
+	}()
+	waitForQueued(b, 1)
+
+	b.release()
+	assert.Nil(t, <-blocked)
+	b.release()
+	assert.Equal(t, 0, b.active)
+}
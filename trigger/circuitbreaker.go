@@ -0,0 +1,408 @@
+package trigger
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/engine/event"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/metrics"
+	"github.com/project-flogo/core/support/redact"
+)
+
+// CircuitState is the state of a circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed allows events through to the action
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen short-circuits events without invoking the action
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen allows a limited number of events through to probe the action
+	CircuitHalfOpen CircuitState = "half-open"
+
+	CircuitBreakerEventType = "circuitbreakerevent"
+
+	DefaultCircuitFailureRatio     = 0.5
+	DefaultCircuitMinRequests      = 10
+	DefaultCircuitWindow           = time.Minute
+	DefaultCircuitCoolDown         = 30 * time.Second
+	DefaultCircuitHalfOpenRequests = 1
+)
+
+// CircuitBreakerConfig is the circuit breaker configuration of a handler action
+type CircuitBreakerConfig struct {
+	// FailureRatio is the ratio of failed events in the window that opens the circuit, defaults to 0.5
+	FailureRatio float64 `json:"failureRatio,omitempty"`
+	// MinRequests is the minimum number of events in the window before the failure ratio is evaluated, defaults to 10
+	MinRequests int `json:"minRequests,omitempty"`
+	// Window is the interval over which failures are counted, defaults to 1m
+	Window string `json:"window,omitempty"`
+	// CoolDown is the time the circuit stays open before allowing probe events, defaults to 30s
+	CoolDown string `json:"coolDown,omitempty"`
+	// HalfOpenRequests is the number of successful probe events required to close the circuit, defaults to 1
+	HalfOpenRequests int `json:"halfOpenRequests,omitempty"`
+	// PauseTrigger pauses the trigger while the circuit is open, if it supports flow control
+	PauseTrigger bool `json:"pauseTrigger,omitempty"`
+}
+
+// CircuitOpenError is returned by a handler when an event is short-circuited by an open circuit breaker
+type CircuitOpenError struct {
+	Trigger string
+	Handler string
+	// RetryAfter is the time after which the circuit allows probe events
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("event rejected by handler [%s] of trigger [%s]: circuit breaker open, retry after %s", e.Handler, e.Trigger, e.RetryAfter)
+}
+
+// CircuitBreakerEvent is posted when the state of a circuit breaker changes
+type CircuitBreakerEvent interface {
+	// TriggerName is the name of trigger the handler belongs to
+	TriggerName() string
+	// HandlerName is the name of the handler
+	HandlerName() string
+	// Action is the index of the handler action the circuit breaker protects
+	Action() int
+	// State is the new state of the circuit breaker
+	State() CircuitState
+	// PreviousState is the previous state of the circuit breaker
+	PreviousState() CircuitState
+}
+
+type circuitBreakerEvent struct {
+	triggerName string
+	handlerName string
+	action      int
+	state       CircuitState
+	previous    CircuitState
+}
+
+func (e *circuitBreakerEvent) TriggerName() string {
+	return e.triggerName
+}
+
+func (e *circuitBreakerEvent) HandlerName() string {
+	return e.handlerName
+}
+
+func (e *circuitBreakerEvent) Action() int {
+	return e.action
+}
+
+func (e *circuitBreakerEvent) State() CircuitState {
+	return e.state
+}
+
+func (e *circuitBreakerEvent) PreviousState() CircuitState {
+	return e.previous
+}
+
+// Redact implements redact.Redactor
+func (e *circuitBreakerEvent) Redact() interface{} {
+	masked := *e
+	masked.triggerName = redact.String(e.triggerName)
+	masked.handlerName = redact.String(e.handlerName)
+	return &masked
+}
+
+// FlowController pauses and resumes triggers on behalf of their handlers
+type FlowController interface {
+	// PauseTrigger pauses the specified trigger
+	PauseTrigger(triggerId string) error
+	// ResumeTrigger resumes the specified trigger
+	ResumeTrigger(triggerId string) error
+}
+
+var (
+	flowControllerMu sync.RWMutex
+	flowController   FlowController
+)
+
+// SetFlowController sets the FlowController used to pause triggers while a circuit is open
+func SetFlowController(fc FlowController) {
+	flowControllerMu.Lock()
+	flowController = fc
+	flowControllerMu.Unlock()
+}
+
+func getFlowController() FlowController {
+	flowControllerMu.RLock()
+	defer flowControllerMu.RUnlock()
+	return flowController
+}
+
+// circuitBreaker stops invoking a failing action until it has had time to recover
+type circuitBreaker struct {
+	triggerId        string
+	handlerName      string
+	action           int
+	failureRatio     float64
+	minRequests      int
+	window           time.Duration
+	coolDown         time.Duration
+	halfOpenRequests int
+	pauseTrigger     bool
+	logger           log.Logger
+	rejected         metrics.Counter
+	stateGauge       metrics.Gauge
+
+	mutex       sync.Mutex
+	state       CircuitState
+	generation  uint64
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	successes   int
+	transitions []*circuitBreakerEvent
+
+	flowMutex sync.Mutex
+	paused    bool
+}
+
+func newCircuitBreaker(hc *HandlerConfig, action int, config *CircuitBreakerConfig, logger log.Logger) (*circuitBreaker, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	cb := &circuitBreaker{handlerName: hc.Name, action: action, failureRatio: DefaultCircuitFailureRatio, minRequests: DefaultCircuitMinRequests,
+		window: DefaultCircuitWindow, coolDown: DefaultCircuitCoolDown, halfOpenRequests: DefaultCircuitHalfOpenRequests,
+		pauseTrigger: config.PauseTrigger, logger: logger, state: CircuitClosed, windowStart: time.Now()}
+	if hc.Parent != nil {
+		cb.triggerId = hc.Parent.Id
+	}
+
+	if config.FailureRatio != 0 {
+		if config.FailureRatio < 0 || config.FailureRatio > 1 {
+			return nil, fmt.Errorf("invalid circuit breaker failure ratio for handler [%s]: %v", hc.Name, config.FailureRatio)
+		}
+		cb.failureRatio = config.FailureRatio
+	}
+	if config.MinRequests > 0 {
+		cb.minRequests = config.MinRequests
+	}
+	if config.HalfOpenRequests > 0 {
+		cb.halfOpenRequests = config.HalfOpenRequests
+	}
+
+	var err error
+	if config.Window != "" {
+		cb.window, err = time.ParseDuration(config.Window)
+		if err != nil || cb.window <= 0 {
+			return nil, fmt.Errorf("invalid circuit breaker window for handler [%s]: %s", hc.Name, config.Window)
+		}
+	}
+	if config.CoolDown != "" {
+		cb.coolDown, err = time.ParseDuration(config.CoolDown)
+		if err != nil || cb.coolDown <= 0 {
+			return nil, fmt.Errorf("invalid circuit breaker cool down for handler [%s]: %s", hc.Name, config.CoolDown)
+		}
+	}
+
+	cb.rejected = handlerRejected.With(cb.triggerId, cb.handlerName, "circuit_open")
+	cb.stateGauge = handlerCircuitState.With(cb.triggerId, cb.handlerName, strconv.Itoa(action))
+	cb.stateGauge.Set(0)
+
+	return cb, nil
+}
+
+// execute invokes the action if the circuit allows it, recording the outcome.  A *CircuitOpenError is
+// returned if the event is short-circuited, in which case a REJECTED handler event is posted.
+func (cb *circuitBreaker) execute(eventData map[string]string, run func() (map[string]interface{}, error)) (results map[string]interface{}, err error) {
+	if cb == nil {
+		return run()
+	}
+
+	generation, err := cb.before(time.Now())
+	if err != nil {
+		cb.rejected.Inc()
+		PostHandlerEvent(REJECTED, cb.handlerName, cb.triggerId, eventData)
+		return nil, err
+	}
+
+	success := false
+	defer func() {
+		// a panic is recorded as a failure
+		cb.after(generation, success, time.Now())
+	}()
+
+	results, err = run()
+	success = err == nil
+
+	return results, err
+}
+
+// State returns the current state of the circuit breaker
+func (cb *circuitBreaker) State() CircuitState {
+	cb.mutex.Lock()
+	defer cb.unlock()
+
+	cb.checkCoolDown(time.Now())
+	return cb.state
+}
+
+func (cb *circuitBreaker) before(now time.Time) (uint64, error) {
+	cb.mutex.Lock()
+	defer cb.unlock()
+
+	cb.checkCoolDown(now)
+
+	switch cb.state {
+	case CircuitOpen:
+		return 0, &CircuitOpenError{Trigger: cb.triggerId, Handler: cb.handlerName, RetryAfter: cb.openedAt.Add(cb.coolDown).Sub(now)}
+	case CircuitHalfOpen:
+		if cb.probes+cb.successes >= cb.halfOpenRequests {
+			return 0, &CircuitOpenError{Trigger: cb.triggerId, Handler: cb.handlerName}
+		}
+		cb.probes++
+	default:
+		if now.Sub(cb.windowStart) >= cb.window {
+			cb.windowStart = now
+			cb.requests = 0
+			cb.failures = 0
+		}
+	}
+
+	return cb.generation, nil
+}
+
+func (cb *circuitBreaker) after(generation uint64, success bool, now time.Time) {
+	cb.mutex.Lock()
+	defer cb.unlock()
+
+	if generation != cb.generation {
+		// the outcome of an event from a previous state is ignored
+		return
+	}
+
+	switch cb.state {
+	case CircuitHalfOpen:
+		cb.probes--
+		if !success {
+			cb.setState(CircuitOpen, now)
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.halfOpenRequests {
+			cb.setState(CircuitClosed, now)
+		}
+	case CircuitClosed:
+		cb.requests++
+		if !success {
+			cb.failures++
+		}
+		if cb.requests >= cb.minRequests && float64(cb.failures)/float64(cb.requests) >= cb.failureRatio {
+			cb.setState(CircuitOpen, now)
+		}
+	}
+}
+
+// checkCoolDown moves an open circuit to half-open once the cool down has elapsed, the lock must be held
+func (cb *circuitBreaker) checkCoolDown(now time.Time) {
+	if cb.state == CircuitOpen && !now.Before(cb.openedAt.Add(cb.coolDown)) {
+		cb.setState(CircuitHalfOpen, now)
+	}
+}
+
+// setState changes the state of the circuit breaker, the lock must be held
+func (cb *circuitBreaker) setState(state CircuitState, now time.Time) {
+	previous := cb.state
+	if previous == state {
+		return
+	}
+
+	cb.state = state
+	cb.generation++
+	cb.windowStart = now
+	cb.requests = 0
+	cb.failures = 0
+	cb.probes = 0
+	cb.successes = 0
+
+	switch state {
+	case CircuitOpen:
+		cb.openedAt = now
+		cb.stateGauge.Set(1)
+		cb.logger.Warnf("Circuit breaker for action %d of handler [%s] opened, events will be rejected for %s", cb.action, cb.handlerName, cb.coolDown)
+		if cb.pauseTrigger {
+			// no events arrive while the trigger is paused, so a timer is needed to end the cool down
+			generation := cb.generation
+			time.AfterFunc(cb.coolDown, func() {
+				cb.mutex.Lock()
+				if cb.generation == generation {
+					cb.checkCoolDown(time.Now())
+				}
+				cb.unlock()
+			})
+		}
+	case CircuitHalfOpen:
+		cb.stateGauge.Set(0.5)
+		cb.logger.Infof("Circuit breaker for action %d of handler [%s] half-open, probing action", cb.action, cb.handlerName)
+	case CircuitClosed:
+		cb.stateGauge.Set(0)
+		cb.logger.Infof("Circuit breaker for action %d of handler [%s] closed", cb.action, cb.handlerName)
+	}
+
+	cb.transitions = append(cb.transitions, &circuitBreakerEvent{triggerName: cb.triggerId, handlerName: cb.handlerName, action: cb.action, state: state, previous: previous})
+}
+
+// unlock releases the lock and then notifies the state changes that occurred while it was held
+func (cb *circuitBreaker) unlock() {
+	transitions := cb.transitions
+	cb.transitions = nil
+	cb.mutex.Unlock()
+
+	if len(transitions) == 0 {
+		return
+	}
+
+	cb.updateTriggerFlow()
+
+	if event.HasListener(CircuitBreakerEventType) {
+		for _, transition := range transitions {
+			event.Post(CircuitBreakerEventType, transition)
+		}
+	}
+}
+
+// updateTriggerFlow pauses the trigger while the circuit is open and resumes it otherwise
+func (cb *circuitBreaker) updateTriggerFlow() {
+	if !cb.pauseTrigger {
+		return
+	}
+
+	fc := getFlowController()
+	if fc == nil {
+		return
+	}
+
+	cb.flowMutex.Lock()
+	defer cb.flowMutex.Unlock()
+
+	cb.mutex.Lock()
+	open := cb.state == CircuitOpen
+	cb.mutex.Unlock()
+
+	if cb.paused == open {
+		return
+	}
+
+	var err error
+	if open {
+		err = fc.PauseTrigger(cb.triggerId)
+	} else {
+		err = fc.ResumeTrigger(cb.triggerId)
+	}
+
+	if err != nil {
+		cb.logger.Warnf("Circuit breaker for handler [%s] unable to update trigger [%s] flow: %s", cb.handlerName, cb.triggerId, err.Error())
+		return
+	}
+	cb.paused = open
+}
@@ -0,0 +1,166 @@
+package trigger
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/mapper"
+	"github.com/project-flogo/core/engine/event"
+	"github.com/project-flogo/core/support/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFlowController struct {
+	mutex  sync.Mutex
+	paused map[string]bool
+}
+
+func (fc *testFlowController) PauseTrigger(triggerId string) error {
+	fc.mutex.Lock()
+	fc.paused[triggerId] = true
+	fc.mutex.Unlock()
+	return nil
+}
+
+func (fc *testFlowController) ResumeTrigger(triggerId string) error {
+	fc.mutex.Lock()
+	fc.paused[triggerId] = false
+	fc.mutex.Unlock()
+	return nil
+}
+
+func (fc *testFlowController) isPaused(triggerId string) bool {
+	fc.mutex.Lock()
+	defer fc.mutex.Unlock()
+	return fc.paused[triggerId]
+}
+
+func newTestCircuitBreaker(t *testing.T, name string, config *CircuitBreakerConfig) *circuitBreaker {
+	hc := &HandlerConfig{Name: name, Parent: &Config{Id: "cbTrig"}}
+	cb, err := newCircuitBreaker(hc, 0, config, log.RootLogger())
+	assert.Nil(t, err)
+	assert.NotNil(t, cb)
+	return cb
+}
+
+func runResult(err error) func() (map[string]interface{}, error) {
+	return func() (map[string]interface{}, error) {
+		return nil, err
+	}
+}
+
+func TestNewCircuitBreaker(t *testing.T) {
+	hc := &HandlerConfig{Name: "cbHandler"}
+
+	cb, err := newCircuitBreaker(hc, 0, nil, log.RootLogger())
+	assert.Nil(t, err)
+	assert.Nil(t, cb)
+	_, err = cb.execute(nil, runResult(nil))
+	assert.Nil(t, err)
+
+	_, err = newCircuitBreaker(hc, 0, &CircuitBreakerConfig{FailureRatio: 2}, log.RootLogger())
+	assert.NotNil(t, err)
+	_, err = newCircuitBreaker(hc, 0, &CircuitBreakerConfig{CoolDown: "abc"}, log.RootLogger())
+	assert.NotNil(t, err)
+}
+
+func TestCircuitBreakerStates(t *testing.T) {
+	cb := newTestCircuitBreaker(t, "cbStates", &CircuitBreakerConfig{FailureRatio: 0.5, MinRequests: 4, CoolDown: "20ms"})
+	failure := errors.New("failed")
+
+	_, _ = cb.execute(nil, runResult(nil))
+	_, _ = cb.execute(nil, runResult(failure))
+	_, _ = cb.execute(nil, runResult(nil))
+	assert.Equal(t, CircuitClosed, cb.State())
+
+	_, _ = cb.execute(nil, runResult(failure))
+	assert.Equal(t, CircuitOpen, cb.State())
+
+	invoked := false
+	_, err := cb.execute(nil, func() (map[string]interface{}, error) {
+		invoked = true
+		return nil, nil
+	})
+	assert.False(t, invoked)
+	assert.IsType(t, &CircuitOpenError{}, err)
+
+	time.Sleep(25 * time.Millisecond)
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+
+	// a failed probe re-opens the circuit
+	_, err = cb.execute(nil, runResult(failure))
+	assert.Equal(t, failure, err)
+	assert.Equal(t, CircuitOpen, cb.State())
+
+	time.Sleep(25 * time.Millisecond)
+	_, err = cb.execute(nil, runResult(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerPauseTrigger(t *testing.T) {
+	fc := &testFlowController{paused: make(map[string]bool)}
+	SetFlowController(fc)
+	defer SetFlowController(nil)
+
+	cb := newTestCircuitBreaker(t, "cbPause", &CircuitBreakerConfig{MinRequests: 1, CoolDown: "20ms", PauseTrigger: true})
+
+	_, _ = cb.execute(nil, runResult(errors.New("failed")))
+	assert.True(t, fc.isPaused("cbTrig"))
+
+	// the trigger is resumed once the cool down elapses, without any events arriving
+	time.Sleep(40 * time.Millisecond)
+	assert.False(t, fc.isPaused("cbTrig"))
+
+	_, err := cb.execute(nil, runResult(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerHandlerEvents(t *testing.T) {
+	listener := &handlerEventListener{events: make(chan HandlerEvent, 10)}
+	err := event.RegisterListener("cbEvents", listener, []string{TriggerEventType})
+	assert.Nil(t, err)
+	defer event.UnRegisterListener("cbEvents", []string{TriggerEventType})
+
+	hCfg := &HandlerConfig{Name: "cbEventsHandler", Actions: []*ActionConfig{{CircuitBreaker: &CircuitBreakerConfig{MinRequests: 1, CoolDown: "1m"}}}}
+	hCfg.Parent = &Config{Id: "cbEventsTrig"}
+
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mapper.NewFactory(defResolver), expression.NewFactory(defResolver), &flakyRunner{failures: 10}, log.RootLogger())
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	// the circuit is open, the event is short-circuited and only reported as rejected
+	_, err = handler.Handle(context.Background(), nil)
+	var openErr *CircuitOpenError
+	assert.True(t, errors.As(err, &openErr))
+
+	var statuses []Status
+	timeout := time.After(time.Second)
+	for len(statuses) < 4 {
+		select {
+		case he := <-listener.events:
+			if he.HandlerName() == "cbEventsHandler" {
+				statuses = append(statuses, he.Status())
+			}
+		case <-timeout:
+			t.Fatalf("expected 4 handler events, got %v", statuses)
+		}
+	}
+	assert.Equal(t, []Status{STARTED, FAILED, STARTED, REJECTED}, statuses)
+
+	select {
+	case he := <-listener.events:
+		if he.HandlerName() == "cbEventsHandler" {
+			t.Errorf("unexpected handler event %s after the event was rejected", he.Status())
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+}
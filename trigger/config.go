@@ -8,6 +8,8 @@ import (
 	"github.com/project-flogo/core/data/expression"
 	"github.com/project-flogo/core/data/metadata"
 	"github.com/project-flogo/core/data/resolve"
+	"github.com/project-flogo/core/support/deadletter"
+	"github.com/project-flogo/core/support/retry"
 	"github.com/project-flogo/core/support/trace"
 )
 
@@ -78,6 +80,10 @@ type HandlerConfig struct {
 	Action   *ActionConfig          `json:"action"`
 	Reply    map[string]interface{} `json:"reply"`
 	Schemas  *SchemaConfig          `json:"schemas,omitempty"`
+
+	Concurrency *ConcurrencyConfig `json:"concurrency,omitempty"`
+	RateLimit   *RateLimitConfig   `json:"rateLimit,omitempty"`
+	DeadLetter  *deadletter.Config `json:"deadLetter,omitempty"`
 }
 
 type SchemaConfig struct {
@@ -94,6 +100,10 @@ func (hc *HandlerConfig) UnmarshalJSON(d []byte) error {
 		Action   *ActionConfig          `json:"action"`
 		Reply    map[string]interface{} `json:"reply"`
 		Schemas  *SchemaConfig          `json:"schemas,omitempty"`
+
+		Concurrency *ConcurrencyConfig `json:"concurrency,omitempty"`
+		RateLimit   *RateLimitConfig   `json:"rateLimit,omitempty"`
+		DeadLetter  *deadletter.Config `json:"deadLetter,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(d, ser); err != nil {
@@ -104,6 +114,9 @@ func (hc *HandlerConfig) UnmarshalJSON(d []byte) error {
 	hc.Settings = ser.Settings
 	hc.Reply = ser.Reply
 	hc.Schemas = ser.Schemas
+	hc.Concurrency = ser.Concurrency
+	hc.RateLimit = ser.RateLimit
+	hc.DeadLetter = ser.DeadLetter
 
 	if ser.Action != nil {
 		hc.Actions = []*ActionConfig{ser.Action}
@@ -117,12 +130,15 @@ func (hc *HandlerConfig) UnmarshalJSON(d []byte) error {
 // ActionConfig is the configuration for the Action
 type ActionConfig struct {
 	*action.Config
-	If          string                 `json:"if,omitempty"`
-	Input       map[string]interface{} `json:"input,omitempty"`
-	Output      map[string]interface{} `json:"output,omitempty"`
-	SequenceKey string                 `json:"seqKey,omitempty"`
-	Tags        interface{}            `json:"tags,omitempty"`
-	Act         action.Action          `json:"-,omitempty"`
+	If             string                 `json:"if,omitempty"`
+	Input          map[string]interface{} `json:"input,omitempty"`
+	Output         map[string]interface{} `json:"output,omitempty"`
+	SequenceKey    string                 `json:"seqKey,omitempty"`
+	Tags           interface{}            `json:"tags,omitempty"`
+	RateLimit      *RateLimitConfig       `json:"rateLimit,omitempty"`
+	CircuitBreaker *CircuitBreakerConfig  `json:"circuitBreaker,omitempty"`
+	Retry          *retry.Config          `json:"retry,omitempty"`
+	Act            action.Action          `json:"-,omitempty"`
 
 	tagDefs *trace.TagDefs
 }
@@ -0,0 +1,151 @@
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/project-flogo/core/support/deadletter"
+	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/metrics"
+)
+
+// handlers holds the handlers, keyed by "<trigger id>/<handler name>", so that dead letters can be replayed
+var handlers sync.Map
+
+func registerHandler(config *HandlerConfig, handler Handler) {
+	handlers.Store(inFlightKey(config), handler)
+}
+
+// deadLetterWriter writes the events a handler failed to handle to a dead-letter sink
+type deadLetterWriter struct {
+	sink        deadletter.Sink
+	triggerId   string
+	handlerName string
+	logger      log.Logger
+	written     metrics.Counter
+}
+
+func newDeadLetterWriter(hc *HandlerConfig, logger log.Logger) (*deadLetterWriter, error) {
+	if hc.DeadLetter == nil {
+		return nil, nil
+	}
+
+	sink, err := deadletter.NewSink(hc.DeadLetter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dead letter configuration for handler [%s]: %s", hc.Name, err.Error())
+	}
+
+	w := &deadLetterWriter{sink: sink, handlerName: hc.Name, logger: logger}
+	if hc.Parent != nil {
+		w.triggerId = hc.Parent.Id
+	}
+	w.written = handlerDeadLetters.With(w.triggerId, w.handlerName)
+
+	return w, nil
+}
+
+// write writes the trigger data of a failed event to the sink, events rejected to shed load and
+// events being replayed are not written
+func (w *deadLetterWriter) write(ctx context.Context, data map[string]interface{}, err error) {
+	if w == nil || err == nil || data == nil || isReplay(ctx) {
+		return
+	}
+
+	var rejectedErr *RejectedError
+	var rateLimitedErr *RateLimitedError
+	if errors.Is(err, ErrDraining) || errors.As(err, &rejectedErr) || errors.As(err, &rateLimitedErr) {
+		return
+	}
+
+	letter := deadletter.New(GetHandlerEventIdFromContext(ctx), w.triggerId, w.handlerName, data, err)
+	if writeErr := w.sink.Write(letter); writeErr != nil {
+		w.logger.Errorf("Unable to write dead letter for event [%s] of handler [%s]: %s", letter.Id, w.handlerName, writeErr.Error())
+		return
+	}
+
+	w.written.Inc()
+	w.logger.Infof("Event [%s] of handler [%s] written to dead letters", letter.Id, w.handlerName)
+}
+
+type replayKeyType int
+
+var replayKey replayKeyType
+
+func isReplay(ctx context.Context) bool {
+	replay, _ := ctx.Value(replayKey).(bool)
+	return replay
+}
+
+// Replay handles the dead letter again using the handler that failed to handle it
+func Replay(ctx context.Context, letter *deadletter.Letter) (map[string]interface{}, error) {
+	h, exists := handlers.Load(letter.Trigger + "/" + letter.Handler)
+	if !exists {
+		return nil, fmt.Errorf("handler [%s] of trigger [%s] not found", letter.Handler, letter.Trigger)
+	}
+
+	return h.(Handler).Handle(context.WithValue(ctx, replayKey, true), letter.Data)
+}
+
+// ReplayReport describes the outcome of replaying the dead letters of a handler
+type ReplayReport struct {
+	// Replayed is the number of dead letters successfully handled, they are removed from the sink
+	Replayed int
+	// Failed are the errors of the dead letters that failed again, keyed by dead letter id
+	Failed map[string]error
+}
+
+// ReplayDeadLetters replays the dead letters of a handler, the handler's dead-letter sink has to support reading
+func ReplayDeadLetters(ctx context.Context, triggerId, handlerName string) (*ReplayReport, error) {
+	h, exists := handlers.Load(triggerId + "/" + handlerName)
+	if !exists {
+		return nil, fmt.Errorf("handler [%s] of trigger [%s] not found", handlerName, triggerId)
+	}
+
+	var w *deadLetterWriter
+	switch handler := h.(type) {
+	case *handlerImpl:
+		w = handler.deadLetter
+	case *seqKeyHandlerImpl:
+		w = handler.deadLetter
+	}
+	if w == nil {
+		return nil, fmt.Errorf("handler [%s] of trigger [%s] has no dead letter sink", handlerName, triggerId)
+	}
+
+	source, ok := w.sink.(deadletter.Source)
+	if !ok {
+		return nil, fmt.Errorf("dead letter sink of handler [%s] of trigger [%s] does not support replay", handlerName, triggerId)
+	}
+
+	letters, err := source.Read(triggerId, handlerName)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReplayReport{Failed: make(map[string]error)}
+	var replayed []string
+
+	for _, letter := range letters {
+		if ctx.Err() != nil {
+			break
+		}
+		_, err := Replay(ctx, letter)
+		if err != nil {
+			report.Failed[letter.Id] = err
+			continue
+		}
+		replayed = append(replayed, letter.Id)
+	}
+
+	report.Replayed = len(replayed)
+	if len(replayed) > 0 {
+		err = source.Delete(triggerId, handlerName, replayed...)
+		if err != nil {
+			return report, fmt.Errorf("unable to remove replayed dead letters: %s", err.Error())
+		}
+	}
+
+	return report, nil
+}
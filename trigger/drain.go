@@ -0,0 +1,94 @@
+package trigger
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrDraining is returned by a handler when the engine is draining and no longer accepts new events
+var ErrDraining = errors.New("engine is draining, event not accepted")
+
+var (
+	draining int32
+	inFlight sync.Map
+)
+
+// inFlightTracker tracks the number of events currently being handled by a handler
+type inFlightTracker struct {
+	count int64
+}
+
+func newInFlightTracker(config *HandlerConfig) *inFlightTracker {
+	name := inFlightKey(config)
+	tracker, _ := inFlight.LoadOrStore(name, &inFlightTracker{})
+	return tracker.(*inFlightTracker)
+}
+
+// enter registers an event with the handler, it returns ErrDraining if the engine is draining
+func (t *inFlightTracker) enter() error {
+	atomic.AddInt64(&t.count, 1)
+	if IsDraining() {
+		atomic.AddInt64(&t.count, -1)
+		return ErrDraining
+	}
+	return nil
+}
+
+// exit unregisters an event from the handler
+func (t *inFlightTracker) exit() {
+	atomic.AddInt64(&t.count, -1)
+}
+
+// Drain stops handlers from accepting new events, it applies to all the handlers of the process
+// until ResumeAccepting is called, which is done when an app is started
+func Drain() {
+	atomic.StoreInt32(&draining, 1)
+}
+
+// ResumeAccepting allows handlers to accept new events again after a Drain
+func ResumeAccepting() {
+	atomic.StoreInt32(&draining, 0)
+}
+
+// IsDraining indicates if handlers have stopped accepting new events
+func IsDraining() bool {
+	return atomic.LoadInt32(&draining) == 1
+}
+
+// InFlight returns the number of events currently being handled, keyed by "<trigger id>/<handler name>"
+func InFlight() map[string]int64 {
+	counts := make(map[string]int64)
+	inFlight.Range(func(key, value interface{}) bool {
+		if count := atomic.LoadInt64(&value.(*inFlightTracker).count); count > 0 {
+			counts[key.(string)] = count
+		}
+		return true
+	})
+	return counts
+}
+
+// WaitForInFlight waits until no events are being handled or the timeout expires, it returns the
+// events still being handled when it gave up
+func WaitForInFlight(timeout time.Duration) map[string]int64 {
+	deadline := time.Now().Add(timeout)
+	for {
+		pending := InFlight()
+		if len(pending) == 0 || !time.Now().Before(deadline) {
+			return pending
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func inFlightKey(config *HandlerConfig) string {
+	triggerId, handlerName := "", ""
+	if config != nil {
+		handlerName = config.Name
+		if config.Parent != nil {
+			triggerId = config.Parent.Id
+		}
+	}
+	return triggerId + "/" + handlerName
+}
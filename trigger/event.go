@@ -5,6 +5,7 @@ import (
 
 	"github.com/project-flogo/core/engine/event"
 	"github.com/project-flogo/core/support/managed"
+	"github.com/project-flogo/core/support/redact"
 )
 
 type triggerStatus struct {
@@ -47,6 +48,7 @@ const (
 	STOPPED          = "Stopped"
 	FAILED           = "Failed"
 	COMPLETED        = "Completed"
+	REJECTED         = "Rejected"
 	TriggerEventType = "triggerevent"
 )
 
@@ -71,12 +73,17 @@ func (te triggerEvent) Status() Status {
 	return te.status
 }
 
+// Redact implements redact.Redactor
+func (te *triggerEvent) Redact() interface{} {
+	return &triggerEvent{name: redact.String(te.name), status: te.status}
+}
+
 type HandlerEvent interface {
 	// Name of trigger this handler belongs to
 	TriggerName() string
 	// Name of the handler
 	HandlerName() string
-	// Status of handler. Valid status - INITIALIZED, STARTED, COMPLETED, FAILED
+	// Status of handler. Valid status - INITIALIZED, STARTED, COMPLETED, FAILED, REJECTED
 	Status() Status
 	// Handler specific tags set by the underlying implementation e.g. method and path by REST trigger handler or
 	// topic name by Kafka trigger handler. This is useful when peek view of trigger(and handlers) is desired.
@@ -106,6 +113,15 @@ func (he handlerEvent) Tags() map[string]string {
 	return he.data
 }
 
+// Redact implements redact.Redactor, the tags can hold values set by the trigger implementation
+func (he *handlerEvent) Redact() interface{} {
+	masked := &handlerEvent{triggerName: redact.String(he.triggerName), name: redact.String(he.name), status: he.status}
+	if he.data != nil {
+		masked.data = redact.Value(he.data).(map[string]string)
+	}
+	return masked
+}
+
 func (s Status) String() string {
 	return string(s)
 }
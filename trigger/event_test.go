@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/project-flogo/core/engine/event"
+	"github.com/project-flogo/core/support/redact"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,3 +30,39 @@ func TestTriggerEvent(t *testing.T) {
 	assert.Equal(t, "TriggerListened", sampleString)
 
 }
+
+type handlerEventListener struct {
+	events chan HandlerEvent
+}
+
+func (l *handlerEventListener) HandleEvent(ctx *event.Context) error {
+	if he, ok := ctx.GetEvent().(HandlerEvent); ok {
+		l.events <- he
+	}
+	return nil
+}
+
+func TestHandlerEventRedacted(t *testing.T) {
+	redact.Track("s3cr3t-token")
+	defer redact.Reset()
+
+	listener := &handlerEventListener{events: make(chan HandlerEvent, 1)}
+	err := event.RegisterListener("redacted", listener, []string{TriggerEventType})
+	assert.Nil(t, err)
+	defer event.UnRegisterListener("redacted", []string{TriggerEventType})
+
+	tags := map[string]string{"path": "/orders", "auth": "Bearer s3cr3t-token"}
+	PostHandlerEvent(STARTED, "handler", "trigger", tags)
+
+	select {
+	case he := <-listener.events:
+		assert.Equal(t, "handler", he.HandlerName())
+		assert.Equal(t, Status(STARTED), he.Status())
+		assert.Equal(t, map[string]string{"path": "/orders", "auth": "Bearer " + redact.Mask}, he.Tags())
+	case <-time.After(time.Second):
+		t.Fatal("handler event not received")
+	}
+
+	// the tags of the handler are not modified
+	assert.Equal(t, "Bearer s3cr3t-token", tags["auth"])
+}
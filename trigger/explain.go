@@ -0,0 +1,42 @@
+package trigger
+
+import (
+	"sync/atomic"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/support/log"
+)
+
+var explainConditions int32
+
+// SetExplainConditions enables or disables logging an explanation of the evaluation of handler conditions
+// that are not true or fail
+func SetExplainConditions(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&explainConditions, v)
+}
+
+// ExplainConditionsEnabled returns whether handler conditions that are not true or fail are explained
+func ExplainConditionsEnabled() bool {
+	return atomic.LoadInt32(&explainConditions) == 1
+}
+
+// evalCondition evaluates the action's condition, if explanations are enabled the evaluation is explained
+// when the condition is not true or fails
+func (a *actImpl) evalCondition(scope data.Scope, logger log.Logger) (interface{}, error) {
+	if !ExplainConditionsEnabled() {
+		return a.condition.Eval(scope)
+	}
+
+	val, explanation, err := expression.Explain(a.condition, scope)
+	if err != nil {
+		logger.Infof("Condition '%s' failed: %s\n%s", a.conditionStr, err.Error(), explanation)
+	} else if b, ok := val.(bool); !ok || !b {
+		logger.Infof("Condition '%s' is not true:\n%s", a.conditionStr, explanation)
+	}
+	return val, err
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/project-flogo/core/data/mapper"
 	"github.com/project-flogo/core/data/property"
 	"github.com/project-flogo/core/support/log"
+	"github.com/project-flogo/core/support/retry"
 	"github.com/project-flogo/core/support/trace"
 )
 
@@ -30,18 +31,27 @@ type Handler interface {
 type actImpl struct {
 	act                action.Action
 	condition          expression.Expr
+	conditionStr       string
 	actionInputMapper  mapper.Mapper
 	actionOutputMapper mapper.Mapper
 	sequenceKey        mapper.Mapper
 	tagDefs            *trace.TagDefs
+	rateLimiter        *rateLimiter
+	circuitBreaker     *circuitBreaker
+	retry              *retry.Policy
 }
 
 type handlerImpl struct {
-	runner    action.Runner
-	logger    log.Logger
-	config    *HandlerConfig
-	acts      []actImpl
-	eventData map[string]string
+	runner      action.Runner
+	logger      log.Logger
+	config      *HandlerConfig
+	acts        []actImpl
+	eventData   map[string]string
+	metrics     *handlerMetrics
+	inFlight    *inFlightTracker
+	bulkhead    *bulkhead
+	rateLimiter *rateLimiter
+	deadLetter  *deadLetterWriter
 }
 
 func (h *handlerImpl) Name() string {
@@ -77,21 +87,52 @@ func NewHandler(config *HandlerConfig, acts []action.Action, mf mapper.Factory,
 		handlerLogger = log.ChildLogger(logger, "handler")
 	}
 
-	handler := &handlerImpl{config: config, acts: make([]actImpl, len(acts)), runner: runner, logger: handlerLogger}
+	handler := &handlerImpl{config: config, acts: make([]actImpl, len(acts)), runner: runner, logger: handlerLogger, metrics: newHandlerMetrics(config), inFlight: newInFlightTracker(config)}
 	var err error
 	var hasSequenceKey bool
 
+	handler.bulkhead, err = newBulkhead(config)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.rateLimiter, err = newRateLimiter(config, config.RateLimit, mf)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.deadLetter, err = newDeadLetterWriter(config, handlerLogger)
+	if err != nil {
+		return nil, err
+	}
+
 	//todo we could filter inputs/outputs based on the metadata, maybe make this an option
 	for i, act := range acts {
 		handler.acts[i].act = act
 		handler.acts[i].tagDefs = config.Actions[i].TagDefs()
 
+		handler.acts[i].rateLimiter, err = newRateLimiter(config, config.Actions[i].RateLimit, mf)
+		if err != nil {
+			return nil, err
+		}
+
+		handler.acts[i].circuitBreaker, err = newCircuitBreaker(config, i, config.Actions[i].CircuitBreaker, handlerLogger)
+		if err != nil {
+			return nil, err
+		}
+
+		handler.acts[i].retry, err = retry.NewPolicy(config.Actions[i].Retry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry policy for handler [%s]: %s", config.Name, err.Error())
+		}
+
 		if config.Actions[i].If != "" {
 			condition, err := ef.NewExpr(config.Actions[i].If)
 			if err != nil {
 				return nil, err
 			}
 			handler.acts[i].condition = condition
+			handler.acts[i].conditionStr = config.Actions[i].If
 		}
 
 		if len(config.Actions[i].Input) != 0 {
@@ -131,10 +172,12 @@ func NewHandler(config *HandlerConfig, acts []action.Action, mf mapper.Factory,
 				seqkeyQueueSize = qSize
 			}
 		}
-		seqKeyHandler := &seqKeyHandlerImpl{config: handler.config, acts: handler.acts, runner: handler.runner, logger: handlerLogger, seqKeyChannelMap: sync.Map{}, seqKeyChannleSize: seqkeyQueueSize}
+		seqKeyHandler := &seqKeyHandlerImpl{config: handler.config, acts: handler.acts, runner: handler.runner, logger: handlerLogger, seqKeyChannelMap: sync.Map{}, seqKeyChannleSize: seqkeyQueueSize, metrics: handler.metrics, seqKeyMetrics: newSeqKeyMetrics(config), inFlight: handler.inFlight, bulkhead: handler.bulkhead, rateLimiter: handler.rateLimiter, deadLetter: handler.deadLetter}
+		registerHandler(config, seqKeyHandler)
 		return seqKeyHandler, nil
 	}
 
+	registerHandler(config, handler)
 	return handler, nil
 }
 
@@ -158,8 +201,20 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 	if h.config != nil && h.config.Name != "" {
 		handlerName = h.config.Name
 	}
+	if err := h.inFlight.enter(); err != nil {
+		return nil, err
+	}
+	defer h.inFlight.exit()
+
+	if err := h.bulkhead.enter(ctx, h.eventData); err != nil {
+		return nil, err
+	}
+	defer h.bulkhead.exit()
+
 	newCtx := NewHandlerContext(ctx, h.config)
+	start := h.metrics.start()
 
+	var triggerValues map[string]interface{}
 	defer func() {
 		h.Logger().Debugf("Handler [%s] for event id [%s] completed in %s", handlerName, GetHandlerEventIdFromContext(newCtx), time.Since(GetHandleStartTimeFromContext(newCtx)).String())
 		if r := recover(); r != nil {
@@ -169,6 +224,8 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 			}
 			err = fmt.Errorf("Unhandled Error while handling handler [%s]: %v", h.Name(), r)
 		}
+		h.deadLetter.write(newCtx, triggerValues, err)
+		h.metrics.done(start, err)
 	}()
 
 	h.Logger().Infof("Executing handler [%s] for event Id [%s]", handlerName, GetHandlerEventIdFromContext(newCtx))
@@ -185,7 +242,6 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 		eventData = ctxEventData
 	}
 
-	var triggerValues map[string]interface{}
 	PostHandlerEvent(STARTED, h.Name(), h.config.Parent.Id, eventData)
 
 	if triggerData == nil {
@@ -200,12 +256,16 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 
 	var act actImpl
 	scope := data.NewSimpleScope(triggerValues, nil)
+	if err := h.rateLimiter.enter(newCtx, scope, eventData); err != nil {
+		return nil, err
+	}
+
 	for _, v := range h.acts {
 		if v.condition == nil {
 			act = v
 			break
 		}
-		val, err := v.condition.Eval(scope)
+		val, err := v.evalCondition(scope, h.logger)
 		if err != nil {
 			return nil, err
 		}
@@ -227,6 +287,10 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 		return nil, nil
 	}
 
+	if err := act.rateLimiter.enter(newCtx, scope, eventData); err != nil {
+		return nil, err
+	}
+
 	var inputMap map[string]interface{}
 
 	if act.actionInputMapper != nil {
@@ -267,9 +331,15 @@ func (h *handlerImpl) Handle(ctx context.Context, triggerData interface{}) (resu
 		inputMap["_trigger_tags"] = trace.ResolveTagDefs(defs, scope)
 	}
 
-	results, err = h.runner.RunAction(newCtx, act.act, inputMap)
+	results, err = act.circuitBreaker.execute(eventData, func() (map[string]interface{}, error) {
+		return act.run(newCtx, h.runner, inputMap, h.Logger(), h.Name(), h.config.Parent.Id, eventData)
+	})
 	if err != nil {
-		PostHandlerEvent(FAILED, h.Name(), h.config.Parent.Id, eventData)
+		// a short-circuited event was already posted as rejected by the circuit breaker
+		var openErr *CircuitOpenError
+		if !errors.As(err, &openErr) {
+			PostHandlerEvent(FAILED, h.Name(), h.config.Parent.Id, eventData)
+		}
 		return nil, err
 	}
 
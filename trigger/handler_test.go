@@ -2,15 +2,23 @@ package trigger
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/project-flogo/core/support/log"
 
 	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/activity"
 	"github.com/project-flogo/core/data/expression"
+	_ "github.com/project-flogo/core/data/expression/script"
 	"github.com/project-flogo/core/data/mapper"
 	"github.com/project-flogo/core/data/metadata"
 	"github.com/project-flogo/core/data/resolve"
+	"github.com/project-flogo/core/support/deadletter"
+	"github.com/project-flogo/core/support/metrics"
+	"github.com/project-flogo/core/support/retry"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,3 +72,189 @@ func TestHandlerContext(t *testing.T) {
 	assert.NotNil(t, NewHandlerContext(context.Background(), hCfg))
 
 }
+
+type mockRunner struct {
+}
+
+func (r *mockRunner) RunAction(ctx context.Context, act action.Action, inputs map[string]interface{}) (map[string]interface{}, error) {
+	return act.(*MockAction).Run(ctx, inputs)
+}
+
+func TestHandlerMetrics(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "metricsHandler", Actions: []*ActionConfig{{}}}
+	hCfg.Parent = &Config{Id: "metricsTrig"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, &mockRunner{}, log.RootLogger())
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"in": "a"})
+	assert.Nil(t, err)
+	_, err = handler.Handle(context.Background(), "unsupported")
+	assert.NotNil(t, err)
+
+	counts := make(map[string]float64)
+	for _, family := range metrics.DefaultRegistry().Gather() {
+		if family.Name == "flogo_handler_requests_total" {
+			for _, sample := range family.Samples {
+				if sample.Labels["handler"] == "metricsHandler" {
+					counts[sample.Labels["status"]] = sample.Value
+				}
+			}
+		}
+	}
+	assert.Equal(t, float64(1), counts[string(COMPLETED)])
+	assert.Equal(t, float64(1), counts[string(FAILED)])
+}
+
+type blockingRunner struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingRunner) RunAction(ctx context.Context, act action.Action, inputs map[string]interface{}) (map[string]interface{}, error) {
+	close(r.started)
+	<-r.release
+	return nil, nil
+}
+
+func TestHandlerDrain(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "drainHandler", Actions: []*ActionConfig{{}}}
+	hCfg.Parent = &Config{Id: "drainTrig"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	runner := &blockingRunner{started: make(chan struct{}), release: make(chan struct{})}
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, runner, log.RootLogger())
+	assert.Nil(t, err)
+
+	done := make(chan error)
+	go func() {
+		_, err := handler.Handle(context.Background(), nil)
+		done <- err
+	}()
+	<-runner.started
+
+	Drain()
+	defer ResumeAccepting()
+
+	_, err = handler.Handle(context.Background(), nil)
+	assert.Equal(t, ErrDraining, err)
+
+	pending := WaitForInFlight(20 * time.Millisecond)
+	assert.Equal(t, int64(1), pending["drainTrig/drainHandler"])
+
+	close(runner.release)
+	assert.Nil(t, <-done)
+
+	pending = WaitForInFlight(time.Second)
+	assert.Empty(t, pending)
+}
+
+type flakyRunner struct {
+	failures int
+	attempts []int
+}
+
+func (r *flakyRunner) RunAction(ctx context.Context, act action.Action, inputs map[string]interface{}) (map[string]interface{}, error) {
+	r.attempts = append(r.attempts, retry.AttemptFromContext(ctx))
+	if len(r.attempts) <= r.failures {
+		return nil, activity.NewRetriableError("temporary failure", "E1", nil)
+	}
+	return inputs, nil
+}
+
+func TestHandlerRetry(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "retryHandler", Actions: []*ActionConfig{{Retry: &retry.Config{MaxAttempts: 3, Delay: "1ms"}}}}
+	hCfg.Parent = &Config{Id: "retryTrig"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	runner := &flakyRunner{failures: 2}
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, runner, log.RootLogger())
+	assert.Nil(t, err)
+
+	results, err := handler.Handle(context.Background(), map[string]interface{}{"in": "a"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, runner.attempts)
+	assert.Equal(t, 3, results["_trigger_tags"].(map[string]interface{})[AttemptTag])
+
+	runner = &flakyRunner{failures: 3}
+	handler, err = NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, runner, log.RootLogger())
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), nil)
+	assert.NotNil(t, err)
+	assert.Len(t, runner.attempts, 3)
+}
+
+func TestHandlerDeadLetter(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "deadLetterHandler", Actions: []*ActionConfig{{}}}
+	hCfg.Parent = &Config{Id: "deadLetterTrig"}
+	hCfg.DeadLetter = &deadletter.Config{Sink: deadletter.SinkTypeFile, Settings: map[string]interface{}{deadletter.SettingDir: t.TempDir()}}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	runner := &flakyRunner{failures: 1}
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, runner, log.RootLogger())
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"in": "a"})
+	assert.NotNil(t, err)
+
+	report, err := ReplayDeadLetters(context.Background(), "deadLetterTrig", "deadLetterHandler")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.Replayed)
+	assert.Empty(t, report.Failed)
+	assert.Len(t, runner.attempts, 2)
+
+	report, err = ReplayDeadLetters(context.Background(), "deadLetterTrig", "deadLetterHandler")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.Replayed)
+}
+
+type conditionLogger struct {
+	log.Logger
+	infos []string
+}
+
+func (l *conditionLogger) Infof(template string, args ...interface{}) {
+	if msg := fmt.Sprintf(template, args...); strings.HasPrefix(msg, "Condition") {
+		l.infos = append(l.infos, msg)
+	}
+}
+
+func TestHandlerExplainConditions(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "explainHandler", Actions: []*ActionConfig{{If: `$.in == "b"`}}}
+	hCfg.Parent = &Config{Id: "explainTrig"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	logger := &conditionLogger{Logger: log.RootLogger()}
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, &mockRunner{}, logger)
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"in": "a"})
+	assert.Nil(t, err)
+	assert.Empty(t, logger.infos)
+
+	SetExplainConditions(true)
+	defer SetExplainConditions(false)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"in": "a"})
+	assert.Nil(t, err)
+	assert.Len(t, logger.infos, 1)
+	assert.Contains(t, logger.infos[0], `Condition '$.in == "b"' is not true`)
+	assert.Contains(t, logger.infos[0], "operator equal to [a == b] => false")
+
+	// conditions that are true are not explained
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"in": "b"})
+	assert.Nil(t, err)
+	assert.Len(t, logger.infos, 1)
+}
@@ -0,0 +1,93 @@
+package trigger
+
+import (
+	"time"
+
+	"github.com/project-flogo/core/support/metrics"
+)
+
+var (
+	handlerRequests = metrics.NewCounter("flogo_handler_requests_total", "Number of events handled by a trigger handler", "trigger", "handler", "status")
+	handlerDuration = metrics.NewHistogram("flogo_handler_duration_seconds", "Time taken by a trigger handler to handle an event", nil, "trigger", "handler")
+	handlerInFlight = metrics.NewGauge("flogo_handler_inflight", "Number of events currently being handled by a trigger handler", "trigger", "handler")
+	seqKeyQueued    = metrics.NewGauge("flogo_handler_seqkey_queued", "Number of events queued for sequential processing by a trigger handler", "trigger", "handler")
+	seqKeyKeys      = metrics.NewGauge("flogo_handler_seqkey_keys", "Number of distinct sequence keys seen by a trigger handler", "trigger", "handler")
+	handlerRejected = metrics.NewCounter("flogo_handler_rejected_total", "Number of events rejected by a trigger handler at capacity", "trigger", "handler", "reason")
+	handlerQueued   = metrics.NewGauge("flogo_handler_queued", "Number of events waiting for a trigger handler concurrency slot", "trigger", "handler")
+
+	handlerDeadLetters  = metrics.NewCounter("flogo_handler_deadletters_total", "Number of failed events written to the dead letters of a trigger handler", "trigger", "handler")
+	handlerCircuitState = metrics.NewGauge("flogo_handler_circuit_open", "State of the circuit breaker of a trigger handler action, open (1), half-open (0.5) or closed (0)", "trigger", "handler", "action")
+)
+
+// handlerMetrics holds the metrics of a handler
+type handlerMetrics struct {
+	completed metrics.Counter
+	failed    metrics.Counter
+	duration  metrics.Histogram
+	inFlight  metrics.Gauge
+}
+
+func newHandlerMetrics(config *HandlerConfig) *handlerMetrics {
+	triggerId, handlerName := "", ""
+	if config != nil {
+		handlerName = config.Name
+		if config.Parent != nil {
+			triggerId = config.Parent.Id
+		}
+	}
+
+	return &handlerMetrics{
+		completed: handlerRequests.With(triggerId, handlerName, string(COMPLETED)),
+		failed:    handlerRequests.With(triggerId, handlerName, string(FAILED)),
+		duration:  handlerDuration.With(triggerId, handlerName),
+		inFlight:  handlerInFlight.With(triggerId, handlerName),
+	}
+}
+
+func (m *handlerMetrics) start() time.Time {
+	m.inFlight.Inc()
+	return time.Now()
+}
+
+func (m *handlerMetrics) done(start time.Time, err error) {
+	m.inFlight.Dec()
+	m.duration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.failed.Inc()
+	} else {
+		m.completed.Inc()
+	}
+}
+
+// seqKeyMetrics holds the sequence key metrics of a handler
+type seqKeyMetrics struct {
+	queued metrics.Gauge
+	keys   metrics.Gauge
+}
+
+func newSeqKeyMetrics(config *HandlerConfig) *seqKeyMetrics {
+	triggerId, handlerName := "", ""
+	if config != nil {
+		handlerName = config.Name
+		if config.Parent != nil {
+			triggerId = config.Parent.Id
+		}
+	}
+
+	return &seqKeyMetrics{queued: seqKeyQueued.With(triggerId, handlerName), keys: seqKeyKeys.With(triggerId, handlerName)}
+}
+
+// bulkheadMetrics holds the concurrency limit metrics of a handler
+type bulkheadMetrics struct {
+	rejected metrics.Counter
+	shed     metrics.Counter
+	queued   metrics.Gauge
+}
+
+func newBulkheadMetrics(triggerId, handlerName string) *bulkheadMetrics {
+	return &bulkheadMetrics{
+		rejected: handlerRejected.With(triggerId, handlerName, "full"),
+		shed:     handlerRejected.With(triggerId, handlerName, "shed"),
+		queued:   handlerQueued.With(triggerId, handlerName),
+	}
+}
@@ -0,0 +1,313 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/project-flogo/core/data"
+	"github.com/project-flogo/core/data/coerce"
+	"github.com/project-flogo/core/data/mapper"
+	"github.com/project-flogo/core/support/metrics"
+)
+
+// RateLimitAlgorithm is the algorithm used to enforce a rate limit
+type RateLimitAlgorithm string
+
+const (
+	// TokenBucket allows bursts of up to 'burst' events, tokens are replenished at 'limit' per 'interval'
+	TokenBucket RateLimitAlgorithm = "tokenBucket"
+	// SlidingWindow allows at most 'limit' events in any 'interval'
+	SlidingWindow RateLimitAlgorithm = "slidingWindow"
+
+	// WhenLimitedReject rejects events that exceed the limit
+	WhenLimitedReject = "reject"
+	// WhenLimitedWait delays events that exceed the limit until they are allowed
+	WhenLimitedWait = "wait"
+
+	DefaultRateLimitInterval = time.Second
+)
+
+// RateLimitConfig is the rate limit configuration of a handler or action
+type RateLimitConfig struct {
+	// Limit is the number of events allowed per interval
+	Limit int `json:"limit"`
+	// Interval is the interval the limit applies to, defaults to 1s
+	Interval string `json:"interval,omitempty"`
+	// Burst is the maximum number of events allowed at once by the token bucket, defaults to the limit
+	Burst int `json:"burst,omitempty"`
+	// Algorithm is the algorithm used to enforce the limit, defaults to tokenBucket
+	Algorithm RateLimitAlgorithm `json:"algorithm,omitempty"`
+	// Key is an optional mapping expression evaluated against the trigger data, a separate limit is applied per key
+	Key string `json:"key,omitempty"`
+	// WhenLimited is the behaviour when the limit is exceeded, reject (default) or wait
+	WhenLimited string `json:"whenLimited,omitempty"`
+}
+
+// RateLimitedError is returned by a handler when an event is rejected because it exceeds a rate limit
+type RateLimitedError struct {
+	Trigger string
+	Handler string
+	Key     string
+	// RetryAfter is the time after which the event would be allowed
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.Key != "" {
+		return fmt.Sprintf("event rejected by handler [%s] of trigger [%s]: rate limit exceeded for key [%s], retry after %s", e.Handler, e.Trigger, e.Key, e.RetryAfter)
+	}
+	return fmt.Sprintf("event rejected by handler [%s] of trigger [%s]: rate limit exceeded, retry after %s", e.Handler, e.Trigger, e.RetryAfter)
+}
+
+// limiter enforces a rate limit
+type limiter interface {
+	// reserve takes a permit if one is available, otherwise it returns the time to wait for one
+	reserve(now time.Time) (ok bool, wait time.Duration)
+	// idle indicates the limiter has fully recovered, so discarding it does not change the limit
+	idle(now time.Time) bool
+}
+
+// rateLimiter applies a rate limit to the events of a handler, optionally partitioned by key
+type rateLimiter struct {
+	triggerId   string
+	handlerName string
+	interval    time.Duration
+	wait        bool
+	keyMapper   mapper.Mapper
+	newLimiter  func(now time.Time) limiter
+	limited     metrics.Counter
+
+	mutex     sync.Mutex
+	limiters  map[string]limiter
+	lastSweep time.Time
+}
+
+func newRateLimiter(hc *HandlerConfig, config *RateLimitConfig, mf mapper.Factory) (*rateLimiter, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	if config.Limit <= 0 {
+		return nil, fmt.Errorf("invalid rate limit for handler [%s]: %d", hc.Name, config.Limit)
+	}
+
+	interval := DefaultRateLimitInterval
+	if config.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(config.Interval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid rate limit interval for handler [%s]: %s", hc.Name, config.Interval)
+		}
+	}
+
+	rl := &rateLimiter{handlerName: hc.Name, interval: interval, limiters: make(map[string]limiter)}
+	if hc.Parent != nil {
+		rl.triggerId = hc.Parent.Id
+	}
+
+	switch {
+	case config.WhenLimited == "", strings.EqualFold(config.WhenLimited, WhenLimitedReject):
+	case strings.EqualFold(config.WhenLimited, WhenLimitedWait):
+		rl.wait = true
+	default:
+		return nil, fmt.Errorf("unsupported rate limit whenLimited behaviour for handler [%s]: %s", hc.Name, config.WhenLimited)
+	}
+
+	limit := float64(config.Limit)
+	switch {
+	case config.Algorithm == "", strings.EqualFold(string(config.Algorithm), string(TokenBucket)):
+		burst := float64(config.Burst)
+		if burst <= 0 {
+			burst = limit
+		}
+		rate := limit / interval.Seconds()
+		rl.newLimiter = func(now time.Time) limiter {
+			return &tokenBucket{rate: rate, capacity: burst, tokens: burst, last: now}
+		}
+	case strings.EqualFold(string(config.Algorithm), string(SlidingWindow)):
+		rl.newLimiter = func(now time.Time) limiter {
+			return &slidingWindow{limit: limit, interval: interval, start: now}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported rate limit algorithm for handler [%s]: %s", hc.Name, config.Algorithm)
+	}
+
+	if config.Key != "" {
+		var err error
+		rl.keyMapper, err = mf.NewMapper(map[string]interface{}{"key": config.Key})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rl.limited = handlerRejected.With(rl.triggerId, rl.handlerName, "rate_limited")
+
+	return rl, nil
+}
+
+// enter checks the event against the rate limit, if the limit is exceeded the event is delayed or
+// rejected with a *RateLimitedError, in which case a REJECTED handler event is posted
+func (rl *rateLimiter) enter(ctx context.Context, scope data.Scope, eventData map[string]string) error {
+	if rl == nil {
+		return nil
+	}
+
+	key, err := rl.key(scope)
+	if err != nil {
+		return err
+	}
+
+	for {
+		ok, wait := rl.reserve(key, time.Now())
+		if ok {
+			return nil
+		}
+
+		if !rl.wait {
+			rl.limited.Inc()
+			PostHandlerEvent(REJECTED, rl.handlerName, rl.triggerId, eventData)
+			return &RateLimitedError{Trigger: rl.triggerId, Handler: rl.handlerName, Key: key, RetryAfter: wait}
+		}
+
+		if wait < time.Millisecond {
+			wait = time.Millisecond
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+func (rl *rateLimiter) key(scope data.Scope) (string, error) {
+	if rl.keyMapper == nil {
+		return "", nil
+	}
+
+	values, err := rl.keyMapper.Apply(scope)
+	if err != nil {
+		return "", err
+	}
+
+	if values["key"] == nil {
+		return "", nil
+	}
+
+	return coerce.ToString(values["key"])
+}
+
+func (rl *rateLimiter) reserve(key string, now time.Time) (bool, time.Duration) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	// discard the limiters of keys that have not been seen recently
+	if now.Sub(rl.lastSweep) > 10*rl.interval {
+		for k, l := range rl.limiters {
+			if l.idle(now) {
+				delete(rl.limiters, k)
+			}
+		}
+		rl.lastSweep = now
+	}
+
+	l, exists := rl.limiters[key]
+	if !exists {
+		l = rl.newLimiter(now)
+		rl.limiters[key] = l
+	}
+
+	return l.reserve(now)
+}
+
+// tokenBucket is a limiter that replenishes tokens at a constant rate
+type tokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(tb.last).Seconds(); elapsed > 0 {
+		tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.rate)
+		tb.last = now
+	}
+}
+
+func (tb *tokenBucket) reserve(now time.Time) (bool, time.Duration) {
+	tb.refill(now)
+
+	if tb.tokens >= 1 {
+		tb.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+func (tb *tokenBucket) idle(now time.Time) bool {
+	tb.refill(now)
+	return tb.tokens >= tb.capacity
+}
+
+// slidingWindow is a limiter that estimates the number of events in the last interval using the counts of
+// the current and the previous fixed windows
+type slidingWindow struct {
+	limit    float64
+	interval time.Duration
+	start    time.Time
+	previous float64
+	current  float64
+}
+
+func (sw *slidingWindow) advance(now time.Time) {
+	elapsed := now.Sub(sw.start)
+	if elapsed < sw.interval {
+		return
+	}
+
+	if elapsed < 2*sw.interval {
+		sw.previous = sw.current
+	} else {
+		sw.previous = 0
+	}
+	sw.current = 0
+	sw.start = sw.start.Add(elapsed / sw.interval * sw.interval)
+}
+
+func (sw *slidingWindow) reserve(now time.Time) (bool, time.Duration) {
+	sw.advance(now)
+
+	elapsed := now.Sub(sw.start)
+	weight := 1 - float64(elapsed)/float64(sw.interval)
+
+	if sw.previous*weight+sw.current < sw.limit {
+		sw.current++
+		return true, 0
+	}
+
+	remaining := sw.interval - elapsed
+	if sw.current >= sw.limit || sw.previous == 0 {
+		// wait for the next window
+		return false, remaining
+	}
+
+	// wait until enough of the previous window has slid out
+	wait := time.Duration((1-(sw.limit-sw.current)/sw.previous)*float64(sw.interval)) - elapsed
+	if wait <= 0 || wait > remaining {
+		wait = remaining
+	}
+	return false, wait
+}
+
+func (sw *slidingWindow) idle(now time.Time) bool {
+	return now.Sub(sw.start) >= 2*sw.interval
+}
@@ -0,0 +1,115 @@
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/data/expression"
+	"github.com/project-flogo/core/data/mapper"
+	"github.com/project-flogo/core/support/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	tb := &tokenBucket{rate: 2, capacity: 2, tokens: 2, last: now}
+
+	ok, _ := tb.reserve(now)
+	assert.True(t, ok)
+	ok, _ = tb.reserve(now)
+	assert.True(t, ok)
+	ok, wait := tb.reserve(now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+	assert.False(t, tb.idle(now))
+
+	ok, _ = tb.reserve(now.Add(wait))
+	assert.True(t, ok)
+	assert.True(t, tb.idle(now.Add(2*time.Second)))
+}
+
+func TestSlidingWindow(t *testing.T) {
+	now := time.Now()
+	sw := &slidingWindow{limit: 2, interval: time.Second, start: now}
+
+	ok, _ := sw.reserve(now)
+	assert.True(t, ok)
+	ok, _ = sw.reserve(now.Add(100 * time.Millisecond))
+	assert.True(t, ok)
+	ok, wait := sw.reserve(now.Add(200 * time.Millisecond))
+	assert.False(t, ok)
+	assert.Equal(t, 800*time.Millisecond, wait)
+
+	// half of the previous window still counts, so only one more event is allowed
+	ok, _ = sw.reserve(now.Add(1500 * time.Millisecond))
+	assert.True(t, ok)
+	ok, _ = sw.reserve(now.Add(1500 * time.Millisecond))
+	assert.False(t, ok)
+
+	assert.True(t, sw.idle(now.Add(3*time.Second)))
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	mf := mapper.NewFactory(defResolver)
+	hc := &HandlerConfig{Name: "rateHandler"}
+
+	rl, err := newRateLimiter(hc, nil, mf)
+	assert.Nil(t, err)
+	assert.Nil(t, rl)
+	assert.Nil(t, rl.enter(context.Background(), nil, nil))
+
+	_, err = newRateLimiter(hc, &RateLimitConfig{Limit: 0}, mf)
+	assert.NotNil(t, err)
+	_, err = newRateLimiter(hc, &RateLimitConfig{Limit: 1, Interval: "abc"}, mf)
+	assert.NotNil(t, err)
+	_, err = newRateLimiter(hc, &RateLimitConfig{Limit: 1, Algorithm: "leakyBucket"}, mf)
+	assert.NotNil(t, err)
+	_, err = newRateLimiter(hc, &RateLimitConfig{Limit: 1, WhenLimited: "drop"}, mf)
+	assert.NotNil(t, err)
+}
+
+func TestHandlerRateLimit(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "rateHandler", Actions: []*ActionConfig{{}}}
+	hCfg.Parent = &Config{Id: "rateTrig"}
+	hCfg.RateLimit = &RateLimitConfig{Limit: 1, Interval: "1h", Key: "=$.user"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, &mockRunner{}, log.RootLogger())
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"user": "a"})
+	assert.Nil(t, err)
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"user": "b"})
+	assert.Nil(t, err)
+
+	_, err = handler.Handle(context.Background(), map[string]interface{}{"user": "a"})
+	assert.IsType(t, &RateLimitedError{}, err)
+	assert.Equal(t, "a", err.(*RateLimitedError).Key)
+}
+
+func TestActionRateLimitWait(t *testing.T) {
+	hCfg := &HandlerConfig{Name: "actionRateHandler", Actions: []*ActionConfig{{RateLimit: &RateLimitConfig{Limit: 1, Interval: "20ms", WhenLimited: WhenLimitedWait}}}}
+	hCfg.Parent = &Config{Id: "rateTrig"}
+
+	mf := mapper.NewFactory(defResolver)
+	expf := expression.NewFactory(defResolver)
+
+	handler, err := NewHandler(hCfg, []action.Action{&MockAction{}}, mf, expf, &mockRunner{}, log.RootLogger())
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, err = handler.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	_, err = handler.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.True(t, time.Since(start) >= 15*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = handler.Handle(ctx, nil)
+	assert.Equal(t, context.Canceled, err)
+}
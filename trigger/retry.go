@@ -0,0 +1,58 @@
+package trigger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/project-flogo/core/action"
+	"github.com/project-flogo/core/support/log"
+)
+
+const (
+	RETRYING = "Retrying"
+
+	// AttemptTag is the trace tag and handler event tag holding the attempt number of a retried action
+	AttemptTag = "retry.attempt"
+)
+
+// run invokes the action, retrying it according to the action's retry policy
+func (act actImpl) run(ctx context.Context, runner action.Runner, inputMap map[string]interface{}, logger log.Logger, handlerName, triggerId string, eventData map[string]string) (map[string]interface{}, error) {
+
+	if act.retry == nil {
+		return runner.RunAction(ctx, act.act, inputMap)
+	}
+
+	var results map[string]interface{}
+	attempts, err := act.retry.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		results, err = runner.RunAction(ctx, act.act, inputMap)
+		return err
+	}, func(attempt int, delay time.Duration, err error) {
+		logger.Warnf("Action of handler [%s] failed, retrying in %s (attempt %d): %s", handlerName, delay, attempt, err.Error())
+
+		tags, _ := inputMap["_trigger_tags"].(map[string]interface{})
+		if tags == nil {
+			tags = make(map[string]interface{}, 1)
+			inputMap["_trigger_tags"] = tags
+		}
+		tags[AttemptTag] = attempt
+
+		retryEventData := make(map[string]string, len(eventData)+1)
+		for k, v := range eventData {
+			retryEventData[k] = v
+		}
+		retryEventData[AttemptTag] = strconv.Itoa(attempt)
+		PostHandlerEvent(RETRYING, handlerName, triggerId, retryEventData)
+	})
+
+	if attempts > 1 {
+		if err != nil {
+			logger.Warnf("Action of handler [%s] failed after %d attempts", handlerName, attempts)
+		} else {
+			logger.Infof("Action of handler [%s] succeeded after %d attempts", handlerName, attempts)
+		}
+	}
+
+	return results, err
+}
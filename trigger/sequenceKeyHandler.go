@@ -26,6 +26,12 @@ type seqKeyHandlerImpl struct {
 	eventData         map[string]string
 	seqKeyChannelMap  sync.Map
 	seqKeyChannleSize int
+	metrics           *handlerMetrics
+	seqKeyMetrics     *seqKeyMetrics
+	inFlight          *inFlightTracker
+	bulkhead          *bulkhead
+	rateLimiter       *rateLimiter
+	deadLetter        *deadLetterWriter
 }
 
 func (h *seqKeyHandlerImpl) Name() string {
@@ -68,8 +74,20 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 	if h.config != nil && h.config.Name != "" {
 		handlerName = h.config.Name
 	}
+	if err := h.inFlight.enter(); err != nil {
+		return nil, err
+	}
+	defer h.inFlight.exit()
+
+	if err := h.bulkhead.enter(ctx, h.eventData); err != nil {
+		return nil, err
+	}
+	defer h.bulkhead.exit()
+
 	newCtx := NewHandlerContext(ctx, h.config)
+	start := h.metrics.start()
 
+	var triggerValues map[string]interface{}
 	defer func() {
 		h.Logger().Debugf("Handler [%s] for event id [%s] completed in %s", handlerName, GetHandlerEventIdFromContext(newCtx), time.Since(GetHandleStartTimeFromContext(newCtx)).String())
 		if r := recover(); r != nil {
@@ -79,10 +97,10 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 			}
 			err = fmt.Errorf("Unhandled Error while handling handler [%s]: %v", h.Name(), r)
 		}
+		h.deadLetter.write(newCtx, triggerValues, err)
+		h.metrics.done(start, err)
 	}()
 
-	var triggerValues map[string]interface{}
-
 	if triggerData == nil {
 		triggerValues = make(map[string]interface{})
 	} else if values, ok := triggerData.(map[string]interface{}); ok {
@@ -95,12 +113,16 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 
 	var act actImpl
 	scope := data.NewSimpleScope(triggerValues, nil)
+	if err := h.rateLimiter.enter(newCtx, scope, h.eventData); err != nil {
+		return nil, err
+	}
+
 	for _, v := range h.acts {
 		if v.condition == nil {
 			act = v
 			break
 		}
-		val, err := v.condition.Eval(scope)
+		val, err := v.evalCondition(scope, h.logger)
 		if err != nil {
 			return nil, err
 		}
@@ -122,6 +144,10 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 		return nil, nil
 	}
 
+	if err := act.rateLimiter.enter(newCtx, scope, h.eventData); err != nil {
+		return nil, err
+	}
+
 	if act.sequenceKey != nil {
 		sequenceKeyObj, err := act.sequenceKey.Apply(scope)
 		if err != nil {
@@ -143,6 +169,7 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 				// Create a new channel for the sequence key
 				runActionChannel = make(chan SeqKayActionWrapper, h.seqKeyChannleSize)
 				h.seqKeyChannelMap.Store(sequenceKeyString, runActionChannel)
+				h.seqKeyMetrics.keys.Inc()
 				// Start a go routine to listen on the channel
 				go h.seqKeyActionListener(runActionChannel.(chan SeqKayActionWrapper), sequenceKeyString)
 			}
@@ -152,6 +179,7 @@ func (h *seqKeyHandlerImpl) Handle(ctx context.Context, triggerData interface{})
 				h.runSeqKeyBasedAction(newCtx, act, scope, triggerValues, handlerName, resultChann)
 			}
 			// Send the action to the channel
+			h.seqKeyMetrics.queued.Inc()
 			runActionChannel.(chan SeqKayActionWrapper) <- runActionWrapper
 
 			// Wait for the reply
@@ -168,6 +196,7 @@ func (h *seqKeyHandlerImpl) seqKeyActionListener(seqActionChannel chan SeqKayAct
 	for seqKayBasedAction := range seqActionChannel {
 		h.logger.Infof("Running action[%s] for sequence key [%s]", h.Name(), seqKey)
 		seqKayBasedAction()
+		h.seqKeyMetrics.queued.Dec()
 		h.logger.Infof("Action[%s] for sequence key [%s] completed", h.Name(), seqKey)
 	}
 }
@@ -233,9 +262,15 @@ func (h *seqKeyHandlerImpl) runAction(ctx context.Context, act actImpl, scope da
 		inputMap["_trigger_tags"] = trace.ResolveTagDefs(defs, scope)
 	}
 
-	results, err = h.runner.RunAction(ctx, act.act, inputMap)
+	results, err = act.circuitBreaker.execute(eventData, func() (map[string]interface{}, error) {
+		return act.run(ctx, h.runner, inputMap, h.Logger(), h.Name(), h.config.Parent.Id, eventData)
+	})
 	if err != nil {
-		PostHandlerEvent(FAILED, h.Name(), h.config.Parent.Id, eventData)
+		// a short-circuited event was already posted as rejected by the circuit breaker
+		var openErr *CircuitOpenError
+		if !errors.As(err, &openErr) {
+			PostHandlerEvent(FAILED, h.Name(), h.config.Parent.Id, eventData)
+		}
 		return nil, err
 	}
 